@@ -32,6 +32,7 @@ const descriptionSourcePath = "docs/reference/cmd/"
 func generateCliYaml(opts *options) error {
 	tp := &cli.TektonParams{}
 	tkn := cmd.Root(tp)
+	removeUserCommands(tkn)
 	disableFlagsInUseLine(tkn)
 	source := filepath.Join(opts.source, descriptionSourcePath)
 	if err := loadLongDescription(tkn, source); err != nil {
@@ -52,6 +53,16 @@ func generateCliYaml(opts *options) error {
 	}
 }
 
+// removeUserCommands removes the commands of the plugins found on the PATH
+// and of the aliases configured by whoever generates the docs
+func removeUserCommands(cmd *cobra.Command) {
+	for _, c := range cmd.Commands() {
+		if c.Annotations["plugin"] != "" || c.Annotations["alias"] != "" {
+			cmd.RemoveCommand(c)
+		}
+	}
+}
+
 func disableFlagsInUseLine(cmd *cobra.Command) {
 	visitAll(cmd, func(ccmd *cobra.Command) {
 		// do not add a `[flags]` to the end of the usage line.
@@ -16,9 +16,12 @@ package main
 
 import (
 	"os"
+	"os/exec"
 
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/cmd"
+	"github.com/tektoncd/cli/pkg/cmd/alias"
+	"github.com/tektoncd/cli/pkg/cmd/plugin"
 
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/oidc"
@@ -28,7 +31,22 @@ func main() {
 	tp := &cli.TektonParams{}
 	tkn := cmd.Root(tp)
 
+	// the aliases are expanded before cobra looks up the command to run, an
+	// alias which can not be expanded reports its error when run
+	args := os.Args[1:]
+	if expanded, err := alias.Expand(tkn, args); err == nil {
+		args = expanded
+		tkn.SetArgs(args)
+	}
+
+	// the PATH is only scanned for plugins when args need them
+	plugin.AddFor(tkn, args)
+
 	if err := tkn.Execute(); err != nil {
+		// a plugin exiting in error exits tkn with the same code
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			os.Exit(exitErr.ExitCode())
+		}
 		os.Exit(1)
 	}
 }
@@ -0,0 +1,96 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+const (
+	// V1alpha1 is the version of the tekton.dev API the commands are
+	// written against
+	V1alpha1 = "v1alpha1"
+	// V1alpha2 is served by newer releases of Tekton Pipelines, its types
+	// are not vendored yet so the commands can't handle its objects
+	V1alpha2 = "v1alpha2"
+)
+
+// APIVersions are the versions of the tekton.dev API known to the client, by
+// order of preference
+var APIVersions = []string{V1alpha1, V1alpha2}
+
+// SupportedAPIVersions are the versions of the tekton.dev API the commands
+// can list, describe, start and log the objects of
+var SupportedAPIVersions = []string{V1alpha1}
+
+// ValidateAPIVersion returns an error for a version the client does not
+// know about, an empty version means the served version is discovered
+func ValidateAPIVersion(version string) error {
+	if version == "" || contains(APIVersions, version) {
+		return nil
+	}
+	return fmt.Errorf("unknown API version %s, must be one of: %s", version, strings.Join(APIVersions, ", "))
+}
+
+// CheckAPIVersion returns an error for a version of the tekton.dev API the
+// commands can't handle the objects of, for every kind of them
+func CheckAPIVersion(version string) error {
+	if contains(SupportedAPIVersions, version) {
+		return nil
+	}
+	return fmt.Errorf("tekton.dev/%s is not supported by this version of tkn, which only handles the tekton.dev/%s objects",
+		version, strings.Join(SupportedAPIVersions, ", "))
+}
+
+// ServedAPIVersion returns the preferred version of the tekton.dev API
+// served by the cluster among the known ones, v1alpha1 is assumed when the
+// versions can't be discovered so that the errors are reported by the
+// commands themselves
+func ServedAPIVersion(kube k8s.Interface) string {
+	groups, err := kube.Discovery().ServerGroups()
+	if err != nil {
+		return V1alpha1
+	}
+
+	var served []string
+	for _, g := range groups.Groups {
+		if g.Name != pipeline.GroupName {
+			continue
+		}
+		for _, v := range g.Versions {
+			served = append(served, v.Version)
+		}
+	}
+
+	for _, v := range APIVersions {
+		if contains(served, v) {
+			return v
+		}
+	}
+	return V1alpha1
+}
+
+func contains(versions []string, version string) bool {
+	for _, v := range versions {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func fakeKube(groupVersions ...string) k8s.Interface {
+	cs := fake.NewSimpleClientset()
+	for _, gv := range groupVersions {
+		cs.Discovery().(*fakediscovery.FakeDiscovery).Resources = append(
+			cs.Discovery().(*fakediscovery.FakeDiscovery).Resources,
+			&metav1.APIResourceList{GroupVersion: gv},
+		)
+	}
+	return cs
+}
+
+func TestServedAPIVersion(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		kube     k8s.Interface
+		expected string
+	}{
+		{
+			name:     "v1alpha1",
+			kube:     fakeKube("tekton.dev/v1alpha1"),
+			expected: V1alpha1,
+		},
+		{
+			name:     "v1alpha1 preferred",
+			kube:     fakeKube("tekton.dev/v1alpha2", "tekton.dev/v1alpha1"),
+			expected: V1alpha1,
+		},
+		{
+			name:     "v1alpha2 only",
+			kube:     fakeKube("tekton.dev/v1alpha2", "triggers.tekton.dev/v1alpha1"),
+			expected: V1alpha2,
+		},
+		{
+			name:     "not served",
+			kube:     fakeKube("apps/v1"),
+			expected: V1alpha1,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expected, ServedAPIVersion(s.kube))
+		})
+	}
+}
+
+func TestCheckAPIVersion(t *testing.T) {
+	assert.NoError(t, CheckAPIVersion(V1alpha1))
+	assert.EqualError(t, CheckAPIVersion(V1alpha2),
+		"tekton.dev/v1alpha2 is not supported by this version of tkn, which only handles the tekton.dev/v1alpha1 objects")
+}
@@ -33,6 +33,9 @@ type Clients struct {
 	Tekton     versioned.Interface
 	Kube       k8s.Interface
 	HTTPClient http.Client
+	// APIVersion is the version of the tekton.dev API the Tekton client
+	// talks to
+	APIVersion string
 }
 
 // Params interface provides
@@ -43,6 +46,9 @@ type Params interface {
 	// SetKubeContext extends the specificity of the above SetKubeConfigPath
 	// by using a context other than the default context in the given kubeconfig
 	SetKubeContext(string)
+	// SetAPIVersion sets the version of the tekton.dev API used by the
+	// clients, the version served by the cluster is used when it's empty
+	SetAPIVersion(string)
 	Clients() (*Clients, error)
 	KubeClient() (k8s.Interface, error)
 
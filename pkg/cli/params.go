@@ -30,6 +30,7 @@ type TektonParams struct {
 	kubeConfigPath string
 	kubeContext    string
 	namespace      string
+	apiVersion     string
 }
 
 // ensure that TektonParams complies with cli.Params interface
@@ -43,6 +44,12 @@ func (p *TektonParams) SetKubeContext(context string) {
 	p.kubeContext = context
 }
 
+// SetAPIVersion forces the version of the tekton.dev API instead of the one
+// discovered from the cluster
+func (p *TektonParams) SetAPIVersion(version string) {
+	p.apiVersion = version
+}
+
 func (p *TektonParams) tektonClient(config *rest.Config) (versioned.Interface, error) {
 	cs, err := versioned.NewForConfig(config)
 	if err != nil {
@@ -99,9 +106,18 @@ func (p *TektonParams) Clients() (*Clients, error) {
 		return nil, err
 	}
 
+	apiVersion := p.apiVersion
+	if apiVersion == "" {
+		apiVersion = ServedAPIVersion(kube)
+	}
+	if err := CheckAPIVersion(apiVersion); err != nil {
+		return nil, err
+	}
+
 	p.clients = &Clients{
-		Tekton: tekton,
-		Kube:   kube,
+		Tekton:     tekton,
+		Kube:       kube,
+		APIVersion: apiVersion,
 	}
 
 	return p.clients, nil
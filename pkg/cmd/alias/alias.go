@@ -0,0 +1,200 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alias
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	shellquote "github.com/kballard/go-shellquote"
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/config"
+)
+
+const (
+	emptyMsg = "No aliases found"
+	header   = "NAME\tEXPANSION"
+	body     = "%s\t%s\n"
+)
+
+func Command() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "alias",
+		Short: "Manage the command aliases",
+		Annotations: map[string]string{
+			"commandType": "utility",
+		},
+	}
+
+	c.AddCommand(
+		setCommand(),
+		listCommand(),
+		deleteCommand(),
+	)
+	return c
+}
+
+func setCommand() *cobra.Command {
+	eg := `
+# Make 'tkn rl' show the logs of the last pipelinerun
+tkn alias set rl 'pipelinerun logs --last -f'
+
+# Make 'tkn start-ns build ci' start the pipeline build in the namespace ci,
+# the arguments not used by the expansion are appended to it
+tkn alias set start-ns 'pipeline start $1 -n $2'
+`
+
+	return &cobra.Command{
+		Use:          "set NAME EXPANSION",
+		Short:        "Sets an alias in the user configuration",
+		Example:      eg,
+		Args:         cobra.ExactArgs(2),
+		SilenceUsage: true,
+		Annotations: map[string]string{
+			"commandType": "utility",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, expansion := args[0], args[1]
+			if err := validate(cmd.Root(), name, expansion); err != nil {
+				return err
+			}
+
+			err := updateUserConfig(func(cfg *config.Config) error {
+				if cfg.Aliases == nil {
+					cfg.Aliases = map[string]string{}
+				}
+				cfg.Aliases[name] = expansion
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Alias %s set to %q\n", name, expansion)
+			return nil
+		},
+	}
+}
+
+func listCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "Lists the aliases of the user and project configuration",
+		Args:    cobra.NoArgs,
+		Annotations: map[string]string{
+			"commandType": "utility",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.Load()
+			if err != nil {
+				return err
+			}
+
+			if len(cfg.Aliases) == 0 {
+				fmt.Fprintln(cmd.OutOrStderr(), emptyMsg)
+				return nil
+			}
+
+			names := make([]string, 0, len(cfg.Aliases))
+			for name := range cfg.Aliases {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 5, 3, ' ', tabwriter.TabIndent)
+			fmt.Fprintln(w, header)
+			for _, name := range names {
+				fmt.Fprintf(w, body, name, cfg.Aliases[name])
+			}
+			return w.Flush()
+		},
+	}
+}
+
+func deleteCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:          "delete NAME",
+		Aliases:      []string{"rm"},
+		Short:        "Deletes an alias from the user configuration",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Annotations: map[string]string{
+			"commandType": "utility",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			err := updateUserConfig(func(cfg *config.Config) error {
+				if _, ok := cfg.Aliases[name]; !ok {
+					return fmt.Errorf("alias %q not found in the user configuration", name)
+				}
+				delete(cfg.Aliases, name)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Alias %s deleted\n", name)
+			return nil
+		},
+	}
+}
+
+// updateUserConfig applies update to the user configuration and writes it,
+// nothing is written if update fails
+func updateUserConfig(update func(*config.Config) error) error {
+	path, err := config.UserFile()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.Read(path)
+	if err != nil {
+		return err
+	}
+
+	if err := update(cfg); err != nil {
+		return err
+	}
+	return config.Write(path, cfg)
+}
+
+// validate checks that the alias doesn't hide a command and that its
+// expansion starts with one
+func validate(root *cobra.Command, name, expansion string) error {
+	if name == "" || strings.ContainsAny(name, " \t") || strings.HasPrefix(name, "-") {
+		return fmt.Errorf("invalid alias name %q", name)
+	}
+	if isCommand(root, name) {
+		return fmt.Errorf("alias %q would hide the %s command", name, name)
+	}
+
+	words, err := shellquote.Split(expansion)
+	if err != nil {
+		return fmt.Errorf("invalid expansion %q: %s", expansion, err)
+	}
+	if len(words) == 0 {
+		return fmt.Errorf("expansion of alias %q is empty", name)
+	}
+
+	c, _, err := root.Find(words)
+	if err != nil || c == root || c.Annotations[annotation] != "" {
+		return fmt.Errorf("expansion %q doesn't start with a tkn command", expansion)
+	}
+	return nil
+}
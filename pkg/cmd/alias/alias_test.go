@@ -0,0 +1,206 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alias
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/config"
+	"github.com/tektoncd/cli/pkg/test"
+)
+
+func testRoot() *cobra.Command {
+	root := &cobra.Command{Use: "tkn"}
+	pipelinerun := &cobra.Command{Use: "pipelinerun", Aliases: []string{"pr"}}
+	pipelinerun.AddCommand(&cobra.Command{Use: "logs", Run: func(*cobra.Command, []string) {}})
+	root.AddCommand(pipelinerun, Command())
+	return root
+}
+
+func TestAlias_expand(t *testing.T) {
+	root := testRoot()
+	Add(root, map[string]string{
+		"rl":      "pipelinerun logs --last -f",
+		"logs-ns": "pipelinerun logs $1 -n '$2' --limit=$1",
+		"pr":      "pipelinerun list",
+		"broken":  "pipelinerun logs 'foo",
+	})
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+		err  string
+	}{
+		{
+			name: "no args",
+			args: nil,
+			want: nil,
+		},
+		{
+			name: "not an alias",
+			args: []string{"pipelinerun", "logs", "foo"},
+			want: []string{"pipelinerun", "logs", "foo"},
+		},
+		{
+			name: "command aliases are not overridden",
+			args: []string{"pr", "logs"},
+			want: []string{"pr", "logs"},
+		},
+		{
+			name: "arguments appended",
+			args: []string{"rl", "-a"},
+			want: []string{"pipelinerun", "logs", "--last", "-f", "-a"},
+		},
+		{
+			name: "placeholders",
+			args: []string{"logs-ns", "5", "ci", "-a"},
+			want: []string{"pipelinerun", "logs", "5", "-n", "ci", "--limit=5", "-a"},
+		},
+		{
+			name: "missing arguments",
+			args: []string{"logs-ns", "5"},
+			err:  `alias "logs-ns" needs 2 argument(s), got 1`,
+		},
+		{
+			name: "invalid expansion",
+			args: []string{"broken"},
+			err:  `invalid alias "broken": Unterminated single-quoted string`,
+		},
+	}
+
+	for _, td := range tests {
+		t.Run(td.name, func(t *testing.T) {
+			got, err := Expand(root, td.args)
+			if td.err != "" {
+				if err == nil {
+					t.Fatalf("error expected here")
+				}
+				test.AssertOutput(t, td.err, err.Error())
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			test.AssertOutput(t, td.want, got)
+		})
+	}
+
+	out, err := test.ExecuteCommand(root, "logs-ns")
+	if err == nil {
+		t.Errorf("error expected when running an alias which can't be expanded")
+	}
+	test.AssertOutput(t, "Error: alias \"logs-ns\" needs 2 argument(s), got 0\n", out)
+}
+
+func TestAlias_set_list_delete(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tkn-alias")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	os.Setenv("XDG_CONFIG_HOME", dir)
+	defer os.Unsetenv("XDG_CONFIG_HOME")
+
+	out, err := test.ExecuteCommand(testRoot(), "alias", "list")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	test.AssertOutput(t, "No aliases found\n", out)
+
+	out, err = test.ExecuteCommand(testRoot(), "alias", "set", "rl", "pipelinerun logs --last -f")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	test.AssertOutput(t, "Alias rl set to \"pipelinerun logs --last -f\"\n", out)
+
+	_, err = test.ExecuteCommand(testRoot(), "alias", "set", "prl", "pr logs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out, err = test.ExecuteCommand(testRoot(), "alias", "list")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	test.AssertOutput(t, "NAME   EXPANSION\nprl    pr logs\nrl     pipelinerun logs --last -f\n", out)
+
+	cfg, err := config.Read(filepath.Join(dir, "tkn", "config.yaml"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	test.AssertOutput(t, map[string]string{"prl": "pr logs", "rl": "pipelinerun logs --last -f"}, cfg.Aliases)
+
+	out, err = test.ExecuteCommand(testRoot(), "alias", "delete", "prl")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	test.AssertOutput(t, "Alias prl deleted\n", out)
+
+	_, err = test.ExecuteCommand(testRoot(), "alias", "delete", "prl")
+	test.AssertOutput(t, `alias "prl" not found in the user configuration`, err.Error())
+
+	invalid := []struct {
+		name, expansion, err string
+	}{
+		{"pr", "pipelinerun logs", `alias "pr" would hide the pr command`},
+		{"-a", "pipelinerun logs", `invalid alias name "-a"`},
+		{"a b", "pipelinerun logs", `invalid alias name "a b"`},
+		{"foo", "", `expansion of alias "foo" is empty`},
+		{"foo", "bar", `expansion "bar" doesn't start with a tkn command`},
+		{"foo", "'bar", `invalid expansion "'bar": Unterminated single-quoted string`},
+	}
+	for _, td := range invalid {
+		_, err := test.ExecuteCommand(testRoot(), "alias", "set", "--", td.name, td.expansion)
+		if err == nil {
+			t.Fatalf("error expected for alias %q set to %q", td.name, td.expansion)
+		}
+		test.AssertOutput(t, td.err, err.Error())
+	}
+}
+
+func TestAlias_set_write_error(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tkn-alias")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	os.Setenv("XDG_CONFIG_HOME", dir)
+	defer os.Unsetenv("XDG_CONFIG_HOME")
+
+	// the configuration links to a directory which doesn't exist, so it is
+	// read as empty and can't be written
+	if err := os.MkdirAll(filepath.Join(dir, "tkn"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(dir, "missing", "config.yaml"), filepath.Join(dir, "tkn", "config.yaml")); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := test.ExecuteCommand(testRoot(), "alias", "set", "rl", "pipelinerun logs --last -f")
+	if err == nil {
+		t.Fatal("error expected when the configuration can't be written")
+	}
+	if strings.Contains(out, "Alias rl set") {
+		t.Errorf("alias reported as set although the configuration wasn't written: %s", out)
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alias
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	shellquote "github.com/kballard/go-shellquote"
+	"github.com/spf13/cobra"
+)
+
+const annotation = "alias"
+
+// placeholder matches the $1, $2, ... in the expansion of an alias
+var placeholder = regexp.MustCompile(`\$([1-9][0-9]*)`)
+
+// Add adds a command for each of the aliases to root, it is only run when
+// its expansion fails and lists the alias in the help. The aliases named
+// after a command of root are skipped.
+func Add(root *cobra.Command, aliases map[string]string) {
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if isCommand(root, name) {
+			continue
+		}
+		root.AddCommand(aliasCommand(root, name, aliases[name]))
+	}
+}
+
+func aliasCommand(root *cobra.Command, name, expansion string) *cobra.Command {
+	return &cobra.Command{
+		Use:   name,
+		Short: expansion,
+		Annotations: map[string]string{
+			"commandType": annotation,
+			annotation:    expansion,
+		},
+		DisableFlagParsing: true,
+		SilenceUsage:       true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// the expansion succeeds before the command is dispatched, so
+			// this is only reached with its error
+			_, err := Expand(root, append([]string{name}, args...))
+			return err
+		},
+	}
+}
+
+func isCommand(root *cobra.Command, name string) bool {
+	for _, c := range root.Commands() {
+		if c.Annotations[annotation] == "" && (c.Name() == name || c.HasAlias(name)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Expand returns args with the alias they start with replaced by its
+// expansion. The $1, $2, ... of the expansion are replaced by the arguments
+// following the alias and the others are appended to it. args are returned
+// as they are if they don't start with an alias.
+func Expand(root *cobra.Command, args []string) ([]string, error) {
+	if len(args) == 0 {
+		return args, nil
+	}
+
+	var expansion string
+	for _, c := range root.Commands() {
+		if c.Name() == args[0] && c.Annotations[annotation] != "" {
+			expansion = c.Annotations[annotation]
+		}
+	}
+	if expansion == "" {
+		return args, nil
+	}
+
+	words, err := shellquote.Split(expansion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid alias %q: %s", args[0], err)
+	}
+
+	params := args[1:]
+	needed := 0
+	for _, m := range placeholder.FindAllStringSubmatch(expansion, -1) {
+		if n, _ := strconv.Atoi(m[1]); n > needed {
+			needed = n
+		}
+	}
+	if needed > len(params) {
+		return nil, fmt.Errorf("alias %q needs %d argument(s), got %d", args[0], needed, len(params))
+	}
+
+	used := map[int]bool{}
+	for i, w := range words {
+		words[i] = placeholder.ReplaceAllStringFunc(w, func(p string) string {
+			n, _ := strconv.Atoi(p[1:])
+			used[n] = true
+			return params[n-1]
+		})
+	}
+
+	for i, p := range params {
+		if !used[i+1] {
+			words = append(words, p)
+		}
+	}
+	return words, nil
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
 	"github.com/tektoncd/cli/pkg/helper/options"
 	validate "github.com/tektoncd/cli/pkg/helper/validate"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,12 +34,15 @@ func deleteCommand(p cli.Params) *cobra.Command {
 tkn clustertask delete foo
 
 tkn ct rm foo
+
+# Delete the ClusterTasks 'foo' and 'bar'
+tkn ct rm foo bar
 `
 
 	c := &cobra.Command{
 		Use:          "delete",
 		Aliases:      []string{"rm"},
-		Short:        "Delete a clustertask resource in a cluster",
+		Short:        "Delete clustertask resources in a cluster",
 		Example:      eg,
 		Args:         cobra.MinimumNArgs(1),
 		SilenceUsage: true,
@@ -52,33 +56,46 @@ tkn ct rm foo
 				Err: cmd.OutOrStderr(),
 			}
 
+			if err := opts.ValidateCascade(); err != nil {
+				return err
+			}
+
 			if err := validate.NamespaceExists(p); err != nil {
 				return err
 			}
 
-			if err := opts.CheckOptions(s, args[0]); err != nil {
+			if err := opts.CheckOptions(s, args...); err != nil {
 				return err
 			}
 
-			return deleteClusterTask(s, p, args[0])
+			return opts.DeleteEach(s, args, func(name string) error {
+				return deleteClusterTask(opts, s, p, name)
+			})
 		},
 	}
 	f.AddFlags(c)
 	c.Flags().BoolVarP(&opts.ForceDelete, "force", "f", false, "Whether to force deletion (default: false)")
+	opts.AddCascadeFlags(c)
 
-	_ = c.MarkZshCompPositionalArgumentCustom(1, "__tkn_get_clustertasks")
+	completion.RegisterArgs(c, 0, "clustertask")
 	return c
 }
 
-func deleteClusterTask(s *cli.Stream, p cli.Params, tName string) error {
+func deleteClusterTask(opts *options.DeleteOptions, s *cli.Stream, p cli.Params, tName string) error {
 	cs, err := p.Clients()
 	if err != nil {
 		return fmt.Errorf("Failed to create tekton client")
 	}
 
-	if err := cs.Tekton.TektonV1alpha1().ClusterTasks().Delete(tName, &metav1.DeleteOptions{}); err != nil {
+	if err := cs.Tekton.TektonV1alpha1().ClusterTasks().Delete(tName, opts.KubeDeleteOptions()); err != nil {
 		return fmt.Errorf("Failed to delete clustertask %q: %s", tName, err)
 	}
+	if err := opts.WaitDeleted(func() error {
+		_, err := cs.Tekton.TektonV1alpha1().ClusterTasks().Get(tName, metav1.GetOptions{})
+		return err
+	}); err != nil {
+		return fmt.Errorf("failed to wait for clustertask %q to be deleted: %s", tName, err)
+	}
 
 	fmt.Fprintf(s.Out, "ClusterTask deleted: %s\n", tName)
 	return nil
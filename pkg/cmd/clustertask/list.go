@@ -23,6 +23,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/cli/pkg/helper/options"
 	validate "github.com/tektoncd/cli/pkg/helper/validate"
 	"github.com/tektoncd/cli/pkg/printer"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
@@ -39,6 +40,7 @@ const (
 )
 
 func listCommand(p cli.Params) *cobra.Command {
+	opts := &options.ListOptions{}
 	f := cliopts.NewPrintFlags("list")
 
 	c := &cobra.Command{
@@ -54,6 +56,10 @@ func listCommand(p cli.Params) *cobra.Command {
 				return err
 			}
 
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
 			output, err := cmd.LocalFlags().GetString("output")
 			if err != nil {
 				fmt.Fprint(os.Stderr, "Error: output option not set properly \n")
@@ -61,28 +67,29 @@ func listCommand(p cli.Params) *cobra.Command {
 			}
 
 			if output != "" {
-				return printClusterTaskListObj(cmd.OutOrStdout(), p, f)
+				return printClusterTaskListObj(cmd.OutOrStdout(), p, f, opts)
 			}
 			stream := &cli.Stream{
 				Out: cmd.OutOrStdout(),
 				Err: cmd.OutOrStderr(),
 			}
-			return printClusterTaskDetails(stream, p)
+			return printClusterTaskDetails(stream, p, opts)
 		},
 	}
-	f.AddFlags(c)
+	printer.AddListFlags(c, f)
+	opts.AddFlags(c)
 
 	return c
 }
 
-func printClusterTaskDetails(s *cli.Stream, p cli.Params) error {
+func printClusterTaskDetails(s *cli.Stream, p cli.Params, opts *options.ListOptions) error {
 
 	cs, err := p.Clients()
 	if err != nil {
 		return err
 	}
 
-	clustertasks, err := listAllClusterTasks(cs.Tekton)
+	clustertasks, err := listAllClusterTasks(cs.Tekton, opts)
 	if err != nil {
 		fmt.Fprintln(s.Err, emptyMsg)
 		return err
@@ -105,27 +112,33 @@ func printClusterTaskDetails(s *cli.Stream, p cli.Params) error {
 	return w.Flush()
 }
 
-func printClusterTaskListObj(w io.Writer, p cli.Params, f *cliopts.PrintFlags) error {
+func printClusterTaskListObj(w io.Writer, p cli.Params, f *cliopts.PrintFlags, opts *options.ListOptions) error {
 	cs, err := p.Clients()
 	if err != nil {
 		return err
 	}
 
-	clustertasks, err := listAllClusterTasks(cs.Tekton)
+	clustertasks, err := listAllClusterTasks(cs.Tekton, opts)
 	if err != nil {
 		return err
 	}
 	return printer.PrintObject(w, clustertasks, f)
 }
 
-func listAllClusterTasks(cs versioned.Interface) (*v1alpha1.ClusterTaskList, error) {
+func listAllClusterTasks(cs versioned.Interface, opts *options.ListOptions) (*v1alpha1.ClusterTaskList, error) {
 	c := cs.TektonV1alpha1().ClusterTasks()
 
-	clustertasks, err := c.List(metav1.ListOptions{})
+	clustertasks, err := c.List(metav1.ListOptions{
+		LabelSelector: opts.LabelSelector(),
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	opts.SortObjects(clustertasks.Items, func(i int) metav1.Object {
+		return &clustertasks.Items[i]
+	})
+
 	// NOTE: this is required for -o json|yaml to work properly since
 	// tektoncd go client fails to set these; probably a bug
 	clustertasks.GetObjectKind().SetGroupVersionKind(
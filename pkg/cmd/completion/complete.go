@@ -0,0 +1,324 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package completion
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/alias"
+	"github.com/tektoncd/cli/pkg/flags"
+)
+
+// CommandName is the name of the hidden command called by the completion
+// scripts with the words of the command line, the last one being the word
+// to complete. It prints a completion per line and then the directive as
+// `:<directive>`.
+const CommandName = "__complete"
+
+// Directive tells the completion scripts what to do with the completions,
+// the directives can be combined
+type Directive int
+
+const (
+	// DirectiveDefault lets the shell complete file names when there is no
+	// completion
+	DirectiveDefault Directive = 0
+	// DirectiveError means the completions could not be computed
+	DirectiveError Directive = 1
+	// DirectiveNoSpace asks the shell not to add a space after the
+	// completion, e.g. for key= completions
+	DirectiveNoSpace Directive = 2
+	// DirectiveNoFileComp stops the shell from completing file names when
+	// there is no completion
+	DirectiveNoFileComp Directive = 4
+)
+
+// Func returns the completions of toComplete for cmd called with args, p
+// is initialised from the flags found on the command line
+type Func func(p cli.Params, cmd *cobra.Command, args []string, toComplete string) ([]string, Directive)
+
+const (
+	// argsAnnotation annotates a command with the name of the func
+	// completing its positional arguments, maxArgsAnnotation with how many
+	// of them are completed
+	argsAnnotation    = "tkn_completion_args"
+	maxArgsAnnotation = "tkn_completion_max_args"
+	// flagAnnotation annotates a flag with the name of the func completing
+	// its values
+	flagAnnotation = "tkn_completion"
+)
+
+var (
+	mutex sync.RWMutex
+	// funcs are the completion funcs by name, the commands and flags refer
+	// to them by their name in their annotations
+	funcs = map[string]Func{}
+
+	// commonFlagFuncs complete the flags added by flags.AddTektonOptions
+	commonFlagFuncs = map[string]Func{
+		"namespace":   Names("namespace"),
+		"context":     contexts,
+		"kubeconfig":  files,
+		"api-version": apiVersions,
+	}
+)
+
+// Define names f for the commands and flags to be completed by it, the kinds
+// of objects completed by Names are defined by their kind, e.g. pipeline
+func Define(name string, f Func) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	funcs[name] = f
+}
+
+func lookup(name string) Func {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	return funcs[name]
+}
+
+// RegisterArgs sets the func defined as name to complete the positional
+// arguments of cmd, up to max of them if max is positive. The completions
+// already on the command line are left out.
+func RegisterArgs(cmd *cobra.Command, max int, name string) {
+	if lookup(name) == nil {
+		panic(fmt.Sprintf("no completion %q for the %s command", name, cmd.Name()))
+	}
+
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[argsAnnotation] = name
+	cmd.Annotations[maxArgsAnnotation] = strconv.Itoa(max)
+}
+
+// RegisterFlag sets the func defined as name to complete the values of the
+// flag of cmd
+func RegisterFlag(cmd *cobra.Command, flagName, name string) {
+	if lookup(name) == nil {
+		panic(fmt.Sprintf("no completion %q for the %s flag", name, flagName))
+	}
+
+	flag := cmd.Flags().Lookup(flagName)
+	if flag == nil {
+		flag = cmd.PersistentFlags().Lookup(flagName)
+	}
+	if flag == nil {
+		panic(fmt.Sprintf("no flag %q to complete for the %s command", flagName, cmd.Name()))
+	}
+
+	if flag.Annotations == nil {
+		flag.Annotations = map[string][]string{}
+	}
+	flag.Annotations[flagAnnotation] = []string{name}
+}
+
+// CompleteCommand returns the hidden command called by the completion
+// scripts
+func CompleteCommand(p cli.Params) *cobra.Command {
+	return &cobra.Command{
+		Use:                CommandName,
+		Short:              "Prints the completions of a command line",
+		Hidden:             true,
+		DisableFlagParsing: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				args = []string{""}
+			}
+
+			completions, directive := complete(p, cmd.Root(), args[:len(args)-1], args[len(args)-1])
+			for _, c := range completions {
+				fmt.Fprintln(cmd.OutOrStdout(), c)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), ":%d\n", directive)
+			return nil
+		},
+	}
+}
+
+func complete(p cli.Params, root *cobra.Command, args []string, toComplete string) ([]string, Directive) {
+	if expanded, err := alias.Expand(root, args); err == nil {
+		args = expanded
+	}
+
+	// the value of a flag given as the last word, e.g. `-n <TAB>`
+	var flag *pflag.Flag
+	if n := len(args); n > 0 {
+		if f := lookupFlag(root, args, args[n-1]); f != nil && f.NoOptDefVal == "" {
+			flag, args = f, args[:n-1]
+		}
+	}
+
+	cmd, rest, err := root.Find(args)
+	if err != nil {
+		return nil, DirectiveError
+	}
+	if cmd.DisableFlagParsing {
+		return nil, DirectiveDefault
+	}
+	_ = cmd.ParseFlags(rest)
+	positional := cmd.Flags().Args()
+
+	// the value of a flag in the word to complete, e.g. `--namespace=<TAB>`
+	var prefix string
+	if flag == nil && strings.HasPrefix(toComplete, "-") {
+		i := strings.Index(toComplete, "=")
+		if i < 0 {
+			return filter(flagNames(cmd), toComplete), DirectiveNoFileComp
+		}
+		if flag = cmd.Flags().Lookup(strings.TrimLeft(toComplete[:i], "-")); flag == nil {
+			return nil, DirectiveNoFileComp
+		}
+		prefix, toComplete = toComplete[:i+1], toComplete[i+1:]
+	}
+
+	var f Func
+	var completions []string
+	directive := DirectiveNoFileComp
+	switch {
+	case flag != nil:
+		f = flagFunc(flag)
+		if f == nil {
+			return nil, DirectiveDefault
+		}
+	case cmd.HasAvailableSubCommands() && len(positional) == 0:
+		for _, c := range cmd.Commands() {
+			if c.IsAvailableCommand() {
+				completions = append(completions, c.Name())
+			}
+		}
+	default:
+		completions = append(completions, cmd.ValidArgs...)
+		f = argsFunc(cmd)
+		if f == nil && len(completions) == 0 {
+			directive = DirectiveDefault
+		}
+	}
+
+	if f != nil {
+		// the errors are left to the completion functions, which may not
+		// need the params, e.g. to complete the contexts
+		if cmd.Flags().Lookup("namespace") != nil {
+			_ = flags.InitParams(p, cmd)
+		}
+		completions, directive = f(p, cmd, positional, toComplete)
+	}
+
+	completions = filter(completions, toComplete)
+	for i := range completions {
+		completions[i] = prefix + completions[i]
+	}
+	return completions, directive
+}
+
+// lookupFlag returns the flag of the command found with args named by
+// arg, e.g. `-n` or `--namespace`
+func lookupFlag(root *cobra.Command, args []string, arg string) *pflag.Flag {
+	if !strings.HasPrefix(arg, "-") || strings.Contains(arg, "=") {
+		return nil
+	}
+
+	cmd, _, err := root.Find(args[:len(args)-1])
+	if err != nil {
+		return nil
+	}
+	fs := cmd.Flags()
+	fs.AddFlagSet(cmd.InheritedFlags())
+
+	if strings.HasPrefix(arg, "--") {
+		return fs.Lookup(arg[2:])
+	}
+	if len(arg) == 2 {
+		return fs.ShorthandLookup(arg[1:])
+	}
+	return nil
+}
+
+// argsFunc returns the func completing the positional arguments of cmd, if
+// any, leaving out the ones already on the command line
+func argsFunc(cmd *cobra.Command) Func {
+	f := lookup(cmd.Annotations[argsAnnotation])
+	if f == nil {
+		return nil
+	}
+	max, _ := strconv.Atoi(cmd.Annotations[maxArgsAnnotation])
+
+	return func(p cli.Params, cmd *cobra.Command, args []string, toComplete string) ([]string, Directive) {
+		if max > 0 && len(args) >= max {
+			return nil, DirectiveNoFileComp
+		}
+
+		completions, directive := f(p, cmd, args, toComplete)
+		return without(completions, args), directive
+	}
+}
+
+func flagFunc(flag *pflag.Flag) Func {
+	if names := flag.Annotations[flagAnnotation]; len(names) > 0 {
+		return lookup(names[0])
+	}
+	return commonFlagFuncs[flag.Name]
+}
+
+func flagNames(cmd *cobra.Command) []string {
+	names := []string{}
+	fs := cmd.Flags()
+	fs.AddFlagSet(cmd.InheritedFlags())
+	fs.VisitAll(func(f *pflag.Flag) {
+		if f.Hidden {
+			return
+		}
+		names = append(names, "--"+f.Name)
+		if f.Shorthand != "" {
+			names = append(names, "-"+f.Shorthand)
+		}
+	})
+	sort.Strings(names)
+	return names
+}
+
+func filter(completions []string, prefix string) []string {
+	filtered := []string{}
+	for _, c := range completions {
+		if strings.HasPrefix(c, prefix) {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+func without(completions, args []string) []string {
+	filtered := []string{}
+	for _, c := range completions {
+		found := false
+		for _, a := range args {
+			if a == c {
+				found = true
+				break
+			}
+		}
+		if !found {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,249 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package completion
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/flags"
+	"github.com/tektoncd/cli/pkg/test"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	tb "github.com/tektoncd/pipeline/test/builder"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testRoot(p cli.Params) *cobra.Command {
+	root := &cobra.Command{Use: "tkn"}
+
+	pipeline := &cobra.Command{
+		Use:     "pipeline",
+		Aliases: []string{"p"},
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return flags.InitParams(p, cmd)
+		},
+	}
+	flags.AddTektonOptions(pipeline)
+
+	describe := &cobra.Command{Use: "describe", Run: func(*cobra.Command, []string) {}}
+	describe.Flags().StringP("serviceaccount", "s", "", "")
+	describe.Flags().BoolP("last", "L", false, "")
+	RegisterArgs(describe, 1, "pipeline")
+	RegisterFlag(describe, "serviceaccount", "serviceaccount")
+
+	del := &cobra.Command{Use: "delete", Run: func(*cobra.Command, []string) {}}
+	RegisterArgs(del, 0, "pipeline")
+
+	start := &cobra.Command{Use: "start", Run: func(*cobra.Command, []string) {}}
+	start.Flags().StringSliceP("param", "p", nil, "")
+	Define("test-params", KeyValue(
+		func(p cli.Params, args []string) ([]string, error) {
+			return []string{"revision", "url"}, nil
+		},
+		func(p cli.Params, args []string, key string) ([]string, error) {
+			return []string{"master", "v1"}, nil
+		},
+	))
+	RegisterFlag(start, "param", "test-params")
+
+	pipeline.AddCommand(describe, del, start)
+	root.AddCommand(pipeline, Command(), CompleteCommand(p))
+	return root
+}
+
+func TestComplete(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tkn-completion")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	kubeconfig := filepath.Join(dir, "kubeconfig")
+	err = ioutil.WriteFile(kubeconfig, []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: c
+  cluster:
+    server: https://localhost
+contexts:
+- name: production
+  context:
+    cluster: c
+- name: staging
+  context:
+    cluster: c
+current-context: staging
+`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		Namespaces: []*corev1.Namespace{
+			{ObjectMeta: metav1.ObjectMeta{Name: "ns"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+		},
+		Pipelines: []*v1alpha1.Pipeline{
+			tb.Pipeline("build", "ns"),
+			tb.Pipeline("bundle", "ns"),
+			tb.Pipeline("release", "ns"),
+			tb.Pipeline("deploy", "other"),
+		},
+	})
+	if _, err := cs.Kube.CoreV1().ServiceAccounts("ns").Create(&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "builder"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "commands",
+			args: []string{""},
+			want: "completion\npipeline\n:4\n",
+		},
+		{
+			name: "subcommands",
+			args: []string{"p", "d"},
+			want: "delete\ndescribe\n:4\n",
+		},
+		{
+			name: "valid args",
+			args: []string{"completion", "f"},
+			want: "fish\n:4\n",
+		},
+		{
+			name: "names",
+			args: []string{"pipeline", "describe", "-n", "ns", "b"},
+			want: "build\nbundle\n:4\n",
+		},
+		{
+			name: "names of another namespace",
+			args: []string{"pipeline", "--namespace=other", "describe", ""},
+			want: "deploy\n:4\n",
+		},
+		{
+			name: "only one name",
+			args: []string{"pipeline", "describe", "-n", "ns", "build", ""},
+			want: ":4\n",
+		},
+		{
+			name: "names not already given",
+			args: []string{"pipeline", "delete", "-n", "ns", "build", "release", ""},
+			want: "bundle\n:4\n",
+		},
+		{
+			name: "flags",
+			args: []string{"pipeline", "describe", "--n"},
+			want: "--namespace\n--nocolour\n:4\n",
+		},
+		{
+			name: "flag value",
+			args: []string{"pipeline", "describe", "build", "-n", "ns", "-s", ""},
+			want: "builder\n:4\n",
+		},
+		{
+			name: "flag value after =",
+			args: []string{"pipeline", "describe", "-n", "ns", "--serviceaccount=b"},
+			want: "--serviceaccount=builder\n:4\n",
+		},
+		{
+			name: "namespaces",
+			args: []string{"pipeline", "describe", "-n", ""},
+			want: "ns\nother\n:4\n",
+		},
+		{
+			name: "contexts",
+			args: []string{"pipeline", "describe", "-k", kubeconfig, "--context", ""},
+			want: "production\nstaging\n:4\n",
+		},
+		{
+			name: "files",
+			args: []string{"pipeline", "describe", "--kubeconfig", ""},
+			want: ":0\n",
+		},
+		{
+			name: "keys",
+			args: []string{"pipeline", "start", "build", "-p", ""},
+			want: "revision=\nurl=\n:6\n",
+		},
+		{
+			name: "values",
+			args: []string{"pipeline", "start", "build", "-p", "revision=m"},
+			want: "revision=master\n:4\n",
+		},
+	}
+
+	for _, td := range tests {
+		t.Run(td.name, func(t *testing.T) {
+			p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+			out, err := test.ExecuteCommand(testRoot(p), append([]string{CommandName}, td.args...)...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			test.AssertOutput(t, td.want, out)
+		})
+	}
+}
+
+func TestCompletion_scripts(t *testing.T) {
+	for _, shell := range []string{"bash", "zsh", "fish", "powershell"} {
+		out, err := test.ExecuteCommand(testRoot(&test.Params{}), "completion", shell)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", shell, err)
+		}
+		test.AssertOutput(t, scripts[shell], out)
+	}
+
+	_, err := test.ExecuteCommand(testRoot(&test.Params{}), "completion", "tcsh")
+	if err == nil {
+		t.Fatal("error expected for an unsupported shell")
+	}
+	test.AssertOutput(t, `unsupported shell "tcsh", must be one of: bash|zsh|fish|powershell`, err.Error())
+}
+
+func TestRegister_annotations(t *testing.T) {
+	testRoot(&test.Params{})
+	defined := len(funcs)
+
+	// the completions are only annotated on the commands and flags, building
+	// more roots defines no more funcs
+	root := testRoot(&test.Params{})
+	if len(funcs) != defined {
+		t.Errorf("%d completion funcs defined, want %d", len(funcs), defined)
+	}
+
+	describe, _, err := root.Find([]string{"pipeline", "describe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertOutput(t, map[string]string{argsAnnotation: "pipeline", maxArgsAnnotation: "1"}, describe.Annotations)
+	test.AssertOutput(t, []string{"serviceaccount"}, describe.Flags().Lookup("serviceaccount").Annotations[flagAnnotation])
+
+	defer func() {
+		if recover() == nil {
+			t.Error("panic expected for an undefined completion")
+		}
+	}()
+	RegisterArgs(describe, 1, "undefined")
+}
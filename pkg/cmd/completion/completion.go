@@ -15,29 +15,25 @@
 package completion
 
 import (
+	"fmt"
 	"io"
-	"os"
 
 	"github.com/spf13/cobra"
 )
 
-var (
-	// ShellCompletionMap define a map between a flag to a custom shell
-	// completion which should be in commonCompletion variable
-	ShellCompletionMap = map[string]string{
-		"namespace":  "__kubectl_get_namespace",
-		"kubeconfig": "_filedir",
-	}
-)
-
 const (
 	desc = `
 This command prints shell completion code which must be evaluated to provide
 interactive completion
 
+The completions of the names, params, resources and tasks are computed by tkn
+with the kubeconfig, context and namespace given on the command line
+
 Supported Shells:
 	- bash
 	- zsh
+	- fish
+	- powershell
 `
 	eg = `
   # generate completion code for bash
@@ -45,105 +41,184 @@ Supported Shells:
 
   # generate completion code for zsh
   source <(tkn completion zsh)
+
+  # generate completion code for fish
+  tkn completion fish | source
+
+  # generate completion code for powershell
+  tkn completion powershell | Out-String | Invoke-Expression
+`
+
+	bashCompletion = `# bash completion for tkn
+__tkn_complete() {
+    local cur words cword
+    if declare -F _get_comp_words_by_ref >/dev/null 2>&1; then
+        _get_comp_words_by_ref -n =: cur words cword
+    else
+        cur="${COMP_WORDS[COMP_CWORD]}"
+        words=("${COMP_WORDS[@]}")
+        cword=${COMP_CWORD}
+    fi
+
+    COMPREPLY=()
+    local out directive
+    out=$("${words[0]}" __complete "${words[@]:1:$((cword-1))}" "${cur}" 2>/dev/null) || return
+    directive=${out##*:}
+    out=${out%:*}
+
+    if (( directive & 1 )); then
+        return
+    fi
+
+    local line
+    while IFS='' read -r line; do
+        [[ -n ${line} ]] && COMPREPLY+=("${line}")
+    done <<< "${out}"
+
+    # the shell only replaces what follows the last = or :
+    if [[ ${cur} == *[=:]* ]]; then
+        local prefix=${cur%"${cur##*[=:]}"}
+        COMPREPLY=("${COMPREPLY[@]#"${prefix}"}")
+    fi
+
+    if (( directive & 2 )); then
+        compopt -o nospace
+    fi
+    if (( directive & 4 )); then
+        compopt +o default
+    fi
+}
+
+complete -o default -F __tkn_complete tkn
 `
 
-	commonCompletion = `
-# Custom function for Completions
-function __tkn_get_object() {
-    local type=$1
-    local util=$2
-    local template begin tkn_out
-    template="{{ range .items  }}{{ .metadata.name }} {{ end }}"
-
-    if [[ ${util} == "kubectl" ]];then
-        tkn_out=($(kubectl get ${type} -o template --template="${template}" 2>/dev/null))
-    elif [[ ${util} == "tkn" ]];then
-        tkn_out=($(tkn ${type} ls -o template --template="${template}" 2>/dev/null))
+	zshCompletion = `#compdef tkn
+# zsh completion for tkn
+_tkn() {
+    local out directive
+    local -a completions
+
+    out=$("${words[1]}" __complete "${(@)words[2,$((CURRENT-1))]}" "${words[CURRENT]}" 2>/dev/null) || return
+    directive=${out##*:}
+    out=${out%:*}
+
+    if (( directive & 1 )); then
+        return 1
     fi
 
-    if [[ -n ${tkn_out} ]]; then
-        [[ -n ${BASH_VERSION} ]] && COMPREPLY=( $( compgen -W "${tkn_out}" -- "$cur" ) )
-        [[ -n ${ZSH_VERSION} ]] && compadd ${tkn_out}
+    completions=("${(@f)out}")
+    completions=(${completions:#})
+
+    if (( ${#completions} == 0 )); then
+        if (( ! (directive & 4) )); then
+            _files
+        fi
+        return
+    fi
+
+    if (( directive & 2 )); then
+        compadd -S '' -- "${completions[@]}"
+    else
+        compadd -- "${completions[@]}"
     fi
 }
 
-function __kubectl_get_namespace() { __tkn_get_object namespace kubectl ;}
-function __kubectl_get_serviceaccount() { __tkn_get_object serviceaccount kubectl ;}
-function __tkn_get_pipeline() { __tkn_get_object pipeline tkn ;}
-function __tkn_get_pipelinerun() { __tkn_get_object pipelinerun tkn ;}
-function __tkn_get_task() { __tkn_get_object task tkn ;}
-function __tkn_get_taskrun() { __tkn_get_object taskrun tkn ;}
-function __tkn_get_pipelineresource() { __tkn_get_object resource tkn ;}
-function __tkn_get_clustertasks() { __tkn_get_object clustertasks tkn ;}
+compdef _tkn tkn
 `
-	bashCompletion = `
-function __custom_func() {
-	case ${last_command} in
-		*_delete|*_start|*_describe|*_logs)
-			obj=${last_command/tkn_/};
-			# really need to find something better for this
-			obj=${obj/_describe/}; obj=${obj/_logs/};obj=${obj/_start/};
-			obj=${obj/_delete/};
-			__tkn_get_object ${obj} tkn
-			return
-			;;
-		*)
-			;;
-	esac
+
+	fishCompletion = `# fish completion for tkn
+function __tkn_complete
+    set -l args (commandline -opc)
+    set -l cur (commandline -ct)
+    set -l out ($args[1] __complete $args[2..-1] "$cur" 2>/dev/null)
+    or return
+
+    set -l directive (string replace -r '^:' '' -- $out[-1])
+    set -e out[-1]
+
+    if test (math "$directive % 2") -eq 1
+        return
+    end
+
+    if test (count $out) -eq 0
+        if test (math "floor($directive / 4) % 2") -eq 0
+            __fish_complete_path "$cur"
+        end
+        return
+    end
+
+    printf '%s\n' $out
+end
+
+complete -c tkn -f -a '(__tkn_complete)'
+`
+
+	powershellCompletion = `# powershell completion for tkn
+Register-ArgumentCompleter -Native -CommandName 'tkn' -ScriptBlock {
+    param($WordToComplete, $CommandAst, $CursorPosition)
+
+    $Line = $CommandAst.Extent.Text
+    $Length = $CursorPosition - $CommandAst.Extent.StartOffset
+    if ($Length -lt $Line.Length) {
+        $Line = $Line.Substring(0, $Length)
+    }
+
+    $Request = ($Line -replace '^\s*\S+', '& tkn __complete')
+    # an empty argument is the word to complete
+    if ($WordToComplete -eq '') {
+        $Request += ' ""'
+    }
+
+    $Out = @(Invoke-Expression $Request 2>$null)
+    if ($Out.Count -eq 0) {
+        return
+    }
+
+    $Directive = [int]($Out[-1].TrimStart(':'))
+    if ($Directive -band 1) {
+        return
+    }
+
+    $Out | Select-Object -SkipLast 1 | Where-Object { $_ -like "$WordToComplete*" } | ForEach-Object {
+        [System.Management.Automation.CompletionResult]::new($_, $_, 'ParameterValue', $_)
+    }
 }
 `
 )
 
+var scripts = map[string]string{
+	"bash":       bashCompletion,
+	"zsh":        zshCompletion,
+	"fish":       fishCompletion,
+	"powershell": powershellCompletion,
+}
+
 func Command() *cobra.Command {
 	var cmd = &cobra.Command{
 		Use:       "completion [SHELL]",
 		Short:     "Prints shell completion scripts",
 		Long:      desc,
-		ValidArgs: []string{"bash", "zsh"},
+		ValidArgs: []string{"bash", "zsh", "fish", "powershell"},
 		Example:   eg,
+		Args:      cobra.ExactArgs(1),
 		Annotations: map[string]string{
 			"commandType": "utility",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) == 1 {
-				switch args[0] {
-				case "bash":
-					return runCompletionBash(os.Stdout, cmd.Parent())
-				case "zsh":
-					return runCompletionZsh(os.Stdout, cmd.Parent())
-				}
-			}
-			return nil
+			return runCompletion(cmd.OutOrStdout(), args[0])
 		},
 	}
 	return cmd
 }
 
-// runCompletionBash generate completion with custom functions for bash
-func runCompletionBash(out io.Writer, tkn *cobra.Command) error {
-	if err := tkn.Root().GenBashCompletion(out); err != nil {
-		return err
-	}
-
-	if _, err := out.Write([]byte(commonCompletion)); err != nil {
-		return err
-	}
-
-	if _, err := out.Write([]byte(bashCompletion)); err != nil {
-		return err
+// runCompletion prints the completion script of shell, all of them call
+// tkn __complete to get the completions
+func runCompletion(out io.Writer, shell string) error {
+	script, ok := scripts[shell]
+	if !ok {
+		return fmt.Errorf("unsupported shell %q, must be one of: bash|zsh|fish|powershell", shell)
 	}
 
-	return nil
-}
-
-// runCompletionZsh generate completion with custom functions for bash
-func runCompletionZsh(out io.Writer, tkn *cobra.Command) error {
-	if err := tkn.Root().GenZshCompletion(out); err != nil {
-		return err
-	}
-
-	if _, err := out.Write([]byte(commonCompletion)); err != nil {
-		return err
-	}
-	return nil
+	_, err := io.WriteString(out, script)
+	return err
 }
@@ -0,0 +1,232 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package completion
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func init() {
+	for _, kind := range []string{"pipeline", "pipelinerun", "task", "taskrun", "clustertask", "pipelineresource", "condition", "namespace", "serviceaccount"} {
+		Define(kind, Names(kind))
+	}
+}
+
+// Names completes the names of the objects of kind in the namespace, kind
+// is one of pipeline, pipelinerun, task, taskrun, clustertask,
+// pipelineresource, condition, namespace or serviceaccount
+func Names(kind string) Func {
+	return func(p cli.Params, cmd *cobra.Command, args []string, toComplete string) ([]string, Directive) {
+		names, err := names(p, kind)
+		if err != nil {
+			return nil, DirectiveError
+		}
+		return names, DirectiveNoFileComp
+	}
+}
+
+func names(p cli.Params, kind string) ([]string, error) {
+	cs, err := p.Clients()
+	if err != nil {
+		return nil, err
+	}
+
+	tekton := cs.Tekton.TektonV1alpha1()
+	ns := p.Namespace()
+	opts := metav1.ListOptions{}
+
+	var objects []metav1.Object
+	switch kind {
+	case "pipeline":
+		list, err := tekton.Pipelines(ns).List(opts)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objects = append(objects, &list.Items[i])
+		}
+	case "pipelinerun":
+		list, err := tekton.PipelineRuns(ns).List(opts)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objects = append(objects, &list.Items[i])
+		}
+	case "task":
+		list, err := tekton.Tasks(ns).List(opts)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objects = append(objects, &list.Items[i])
+		}
+	case "taskrun":
+		list, err := tekton.TaskRuns(ns).List(opts)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objects = append(objects, &list.Items[i])
+		}
+	case "clustertask":
+		list, err := tekton.ClusterTasks().List(opts)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objects = append(objects, &list.Items[i])
+		}
+	case "pipelineresource":
+		list, err := tekton.PipelineResources(ns).List(opts)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objects = append(objects, &list.Items[i])
+		}
+	case "condition":
+		list, err := tekton.Conditions(ns).List(opts)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objects = append(objects, &list.Items[i])
+		}
+	case "namespace":
+		list, err := cs.Kube.CoreV1().Namespaces().List(opts)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objects = append(objects, &list.Items[i])
+		}
+	case "serviceaccount":
+		list, err := cs.Kube.CoreV1().ServiceAccounts(ns).List(opts)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objects = append(objects, &list.Items[i])
+		}
+	}
+
+	names := make([]string, 0, len(objects))
+	for _, o := range objects {
+		names = append(names, o.GetName())
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// KeyValue completes the key=value flags, keys returns the keys for the
+// arguments of the command and values the values of a key. values may
+// be nil when the values can't be completed.
+func KeyValue(keys func(p cli.Params, args []string) ([]string, error), values func(p cli.Params, args []string, key string) ([]string, error)) Func {
+	return func(p cli.Params, cmd *cobra.Command, args []string, toComplete string) ([]string, Directive) {
+		i := strings.Index(toComplete, "=")
+		if i < 0 {
+			ks, err := keys(p, args)
+			if err != nil {
+				return nil, DirectiveError
+			}
+			completions := make([]string, 0, len(ks))
+			for _, k := range ks {
+				completions = append(completions, k+"=")
+			}
+			return completions, DirectiveNoSpace | DirectiveNoFileComp
+		}
+
+		if values == nil {
+			return nil, DirectiveNoFileComp
+		}
+		key := toComplete[:i]
+		vs, err := values(p, args, key)
+		if err != nil {
+			return nil, DirectiveError
+		}
+		completions := make([]string, 0, len(vs))
+		for _, v := range vs {
+			completions = append(completions, key+"="+v)
+		}
+		return completions, DirectiveNoFileComp
+	}
+}
+
+// ServiceAccounts returns the service accounts of the namespace, for the
+// values of KeyValue
+func ServiceAccounts(p cli.Params, args []string, key string) ([]string, error) {
+	return names(p, "serviceaccount")
+}
+
+// Resources returns the names of the pipeline resources of type
+// resourceType, of any type if it is empty
+func Resources(p cli.Params, resourceType v1alpha1.PipelineResourceType) ([]string, error) {
+	cs, err := p.Clients()
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := cs.Tekton.TektonV1alpha1().PipelineResources(p.Namespace()).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, r := range list.Items {
+		if resourceType == "" || r.Spec.Type == resourceType {
+			names = append(names, r.Name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// contexts completes the contexts of the kubeconfig
+func contexts(p cli.Params, cmd *cobra.Command, args []string, toComplete string) ([]string, Directive) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if path, err := cmd.Flags().GetString("kubeconfig"); err == nil && path != "" {
+		loadingRules.ExplicitPath = path
+	}
+
+	config, err := loadingRules.Load()
+	if err != nil {
+		return nil, DirectiveError
+	}
+
+	contexts := make([]string, 0, len(config.Contexts))
+	for name := range config.Contexts {
+		contexts = append(contexts, name)
+	}
+	sort.Strings(contexts)
+	return contexts, DirectiveNoFileComp
+}
+
+// apiVersions completes the versions of the tekton.dev API of --api-version
+func apiVersions(p cli.Params, cmd *cobra.Command, args []string, toComplete string) ([]string, Directive) {
+	return cli.APIVersions, DirectiveNoFileComp
+}
+
+// files lets the shell complete the file names
+func files(p cli.Params, cmd *cobra.Command, args []string, toComplete string) ([]string, Directive) {
+	return nil, DirectiveDefault
+}
@@ -19,7 +19,9 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
 	"github.com/tektoncd/cli/pkg/helper/options"
+	phelper "github.com/tektoncd/cli/pkg/helper/pipeline"
 	validate "github.com/tektoncd/cli/pkg/helper/validate"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	cliopts "k8s.io/cli-runtime/pkg/genericclioptions"
@@ -33,12 +35,15 @@ func deleteCommand(p cli.Params) *cobra.Command {
 tkn condition delete foo -n bar
 
 tkn cond rm foo -n bar
+
+# Delete the Conditions 'foo' and 'baz' in namespace 'bar'
+tkn cond rm foo baz -n bar
 `
 
 	c := &cobra.Command{
 		Use:          "delete",
 		Aliases:      []string{"rm"},
-		Short:        "Delete a condition in a namespace",
+		Short:        "Delete conditions in a namespace",
 		Example:      eg,
 		Args:         cobra.MinimumNArgs(1),
 		SilenceUsage: true,
@@ -52,32 +57,54 @@ tkn cond rm foo -n bar
 				Err: cmd.OutOrStderr(),
 			}
 
+			if err := opts.ValidateCascade(); err != nil {
+				return err
+			}
+
 			if err := validate.NamespaceExists(p); err != nil {
 				return err
 			}
 
-			if err := opts.CheckOptions(s, args[0]); err != nil {
+			cs, err := p.Clients()
+			if err != nil {
+				return fmt.Errorf("failed to create tekton client")
+			}
+
+			if opts.Dependents, err = phelper.ConditionDependents(cs.Tekton, p.Namespace(), args); err != nil {
+				return fmt.Errorf("failed to look up the pipelines using the conditions: %s", err)
+			}
+
+			if err := opts.CheckOptions(s, args...); err != nil {
 				return err
 			}
 
-			return deleteCondition(s, p, args[0])
+			return opts.DeleteEach(s, args, func(name string) error {
+				return deleteCondition(opts, s, p, name)
+			})
 		},
 	}
 	f.AddFlags(c)
-	c.Flags().BoolVarP(&opts.ForceDelete, "force", "f", false, "Whether to force deletion (default: false)")
-	_ = c.MarkZshCompPositionalArgumentCustom(1, "__tkn_get_condition")
+	c.Flags().BoolVarP(&opts.ForceDelete, "force", "f", false, "Whether to force deletion, even of conditions used by pipelines (default: false)")
+	opts.AddCascadeFlags(c)
+	completion.RegisterArgs(c, 0, "condition")
 	return c
 }
 
-func deleteCondition(s *cli.Stream, p cli.Params, condName string) error {
+func deleteCondition(opts *options.DeleteOptions, s *cli.Stream, p cli.Params, condName string) error {
 	cs, err := p.Clients()
 	if err != nil {
 		return fmt.Errorf("failed to create tekton client")
 	}
 
-	if err := cs.Tekton.TektonV1alpha1().Conditions(p.Namespace()).Delete(condName, &metav1.DeleteOptions{}); err != nil {
+	if err := cs.Tekton.TektonV1alpha1().Conditions(p.Namespace()).Delete(condName, opts.KubeDeleteOptions()); err != nil {
 		return fmt.Errorf("failed to delete condition %q: %s", condName, err)
 	}
+	if err := opts.WaitDeleted(func() error {
+		_, err := cs.Tekton.TektonV1alpha1().Conditions(p.Namespace()).Get(condName, metav1.GetOptions{})
+		return err
+	}); err != nil {
+		return fmt.Errorf("failed to wait for condition %q to be deleted: %s", condName, err)
+	}
 
 	fmt.Fprintf(s.Out, "Condition deleted: %s\n", condName)
 	return nil
@@ -21,7 +21,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/formatted"
-	validate "github.com/tektoncd/cli/pkg/helper/validate"
+	"github.com/tektoncd/cli/pkg/helper/options"
 	"github.com/tektoncd/cli/pkg/printer"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
 	"github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
@@ -37,6 +37,7 @@ const (
 )
 
 func listCommand(p cli.Params) *cobra.Command {
+	opts := &options.ListOptions{}
 	f := cliopts.NewPrintFlags("list")
 
 	c := &cobra.Command{
@@ -52,7 +53,11 @@ func listCommand(p cli.Params) *cobra.Command {
 				Err: cmd.OutOrStderr(),
 			}
 
-			if err := validate.NamespaceExists(p); err != nil {
+			if err := opts.ValidateNamespace(p); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
 				return err
 			}
 
@@ -63,24 +68,26 @@ func listCommand(p cli.Params) *cobra.Command {
 			}
 
 			if output != "" {
-				return printConditionListObj(stream, p, f)
+				return printConditionListObj(stream, p, f, opts)
 			}
-			return printConditionDetails(stream, p)
+			return printConditionDetails(stream, p, opts)
 		},
 	}
-	f.AddFlags(c)
+	printer.AddListFlags(c, f)
+	opts.AddFlags(c)
+	opts.AddAllNamespacesFlag(c)
 
 	return c
 }
 
-func printConditionDetails(s *cli.Stream, p cli.Params) error {
+func printConditionDetails(s *cli.Stream, p cli.Params, opts *options.ListOptions) error {
 
 	cs, err := p.Clients()
 	if err != nil {
 		return err
 	}
 
-	conditions, err := listAllConditions(cs.Tekton, p.Namespace())
+	conditions, err := listAllConditions(cs.Tekton, opts.Namespace(p), opts)
 	if err != nil {
 		fmt.Fprintf(s.Err, "Failed to list conditions from %s namespace \n", p.Namespace())
 		return err
@@ -92,9 +99,15 @@ func printConditionDetails(s *cli.Stream, p cli.Params) error {
 	}
 
 	w := tabwriter.NewWriter(s.Out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	if opts.AllNamespaces {
+		fmt.Fprint(w, "NAMESPACE\t")
+	}
 	fmt.Fprintln(w, header)
 
 	for _, condition := range conditions.Items {
+		if opts.AllNamespaces {
+			fmt.Fprintf(w, "%s\t", condition.Namespace)
+		}
 		fmt.Fprintf(w, body,
 			condition.Name,
 			formatted.Age(&condition.CreationTimestamp, p.Time()),
@@ -103,13 +116,13 @@ func printConditionDetails(s *cli.Stream, p cli.Params) error {
 	return w.Flush()
 }
 
-func printConditionListObj(s *cli.Stream, p cli.Params, f *cliopts.PrintFlags) error {
+func printConditionListObj(s *cli.Stream, p cli.Params, f *cliopts.PrintFlags, opts *options.ListOptions) error {
 	cs, err := p.Clients()
 	if err != nil {
 		return err
 	}
 
-	conditions, err := listAllConditions(cs.Tekton, p.Namespace())
+	conditions, err := listAllConditions(cs.Tekton, opts.Namespace(p), opts)
 	if err != nil {
 		fmt.Fprintf(s.Err, "Failed to list conditions from %s namespace \n", p.Namespace())
 		return err
@@ -117,14 +130,20 @@ func printConditionListObj(s *cli.Stream, p cli.Params, f *cliopts.PrintFlags) e
 	return printer.PrintObject(s.Out, conditions, f)
 }
 
-func listAllConditions(cs versioned.Interface, ns string) (*v1alpha1.ConditionList, error) {
+func listAllConditions(cs versioned.Interface, ns string, opts *options.ListOptions) (*v1alpha1.ConditionList, error) {
 	c := cs.TektonV1alpha1().Conditions(ns)
 
-	conditions, err := c.List(metav1.ListOptions{})
+	conditions, err := c.List(metav1.ListOptions{
+		LabelSelector: opts.LabelSelector(),
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	opts.SortObjects(conditions.Items, func(i int) metav1.Object {
+		return &conditions.Items[i]
+	})
+
 	// NOTE: this is required for -o json|yaml to work properly since
 	// tektoncd go client fails to set these; probably a bug
 	conditions.GetObjectKind().SetGroupVersionKind(
@@ -0,0 +1,242 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/flags"
+	validateinput "github.com/tektoncd/cli/pkg/helper/validate"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const lastAppliedConfig = "kubectl.kubernetes.io/last-applied-configuration"
+
+// kinds are the kinds which can be exported, in the order they are
+// written so that what an object refers to is created before it
+var kinds = []string{"clustertask", "task", "condition", "pipelineresource", "pipeline"}
+
+// runKinds are the kinds of the runs exported with --include-runs
+var runKinds = []string{"pipelinerun", "taskrun"}
+
+type exportOptions struct {
+	Kinds       []string
+	IncludeRuns bool
+}
+
+func Command(p cli.Params) *cobra.Command {
+	opts := &exportOptions{}
+	eg := `
+# Export the Tasks, ClusterTasks, Pipelines, Conditions and PipelineResources of the namespace 'foo'
+tkn export -n foo > bundle.yaml
+
+# Export the Tasks and Pipelines of the namespace 'foo' along with their runs
+tkn export -n foo --kinds task,pipeline --include-runs > bundle.yaml
+`
+
+	c := &cobra.Command{
+		Use:          "export",
+		Short:        "Export the tekton resources of a namespace as manifests",
+		Example:      eg,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return flags.InitParams(p, cmd)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, k := range opts.Kinds {
+				if !contains(kinds, k) {
+					return fmt.Errorf("invalid kind %q, must be one of: %s", k, strings.Join(kinds, "|"))
+				}
+			}
+
+			if err := validateinput.NamespaceExists(p); err != nil {
+				return err
+			}
+
+			return export(cmd.OutOrStdout(), p, opts)
+		},
+	}
+
+	flags.AddTektonOptions(c)
+	c.Flags().StringSliceVarP(&opts.Kinds, "kinds", "", kinds, "kinds of the resources to export, of: "+strings.Join(kinds, "|"))
+	c.Flags().BoolVarP(&opts.IncludeRuns, "include-runs", "", false, "export the pipelineruns and the taskruns which are not part of a pipelinerun too")
+
+	return c
+}
+
+func export(out io.Writer, p cli.Params, opts *exportOptions) error {
+	cs, err := p.Clients()
+	if err != nil {
+		return fmt.Errorf("failed to create tekton client")
+	}
+
+	exported := append([]string{}, kinds...)
+	if opts.IncludeRuns {
+		exported = append(exported, runKinds...)
+	}
+
+	tekton := cs.Tekton.TektonV1alpha1()
+	ns := p.Namespace()
+	for _, kind := range exported {
+		if !contains(opts.Kinds, kind) && !contains(runKinds, kind) {
+			continue
+		}
+
+		var objects []runtime.Object
+		switch kind {
+		case "clustertask":
+			list, err := tekton.ClusterTasks().List(metav1.ListOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to list clustertasks: %s", err)
+			}
+			for i := range list.Items {
+				objects = append(objects, &list.Items[i])
+			}
+		case "task":
+			list, err := tekton.Tasks(ns).List(metav1.ListOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to list tasks from %s namespace: %s", ns, err)
+			}
+			for i := range list.Items {
+				objects = append(objects, &list.Items[i])
+			}
+		case "condition":
+			list, err := tekton.Conditions(ns).List(metav1.ListOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to list conditions from %s namespace: %s", ns, err)
+			}
+			for i := range list.Items {
+				objects = append(objects, &list.Items[i])
+			}
+		case "pipelineresource":
+			list, err := tekton.PipelineResources(ns).List(metav1.ListOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to list pipelineresources from %s namespace: %s", ns, err)
+			}
+			for i := range list.Items {
+				objects = append(objects, &list.Items[i])
+			}
+		case "pipeline":
+			list, err := tekton.Pipelines(ns).List(metav1.ListOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to list pipelines from %s namespace: %s", ns, err)
+			}
+			for i := range list.Items {
+				objects = append(objects, &list.Items[i])
+			}
+		case "pipelinerun":
+			list, err := tekton.PipelineRuns(ns).List(metav1.ListOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to list pipelineruns from %s namespace: %s", ns, err)
+			}
+			for i := range list.Items {
+				objects = append(objects, &list.Items[i])
+			}
+		case "taskrun":
+			// the taskruns of the pipelineruns are created again by them
+			list, err := tekton.TaskRuns(ns).List(metav1.ListOptions{LabelSelector: "!tekton.dev/pipelineRun"})
+			if err != nil {
+				return fmt.Errorf("failed to list taskruns from %s namespace: %s", ns, err)
+			}
+			for i := range list.Items {
+				objects = append(objects, &list.Items[i])
+			}
+		}
+
+		for _, o := range objects {
+			if err := writeManifest(out, kind, o); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// writeManifest writes the object as a yaml document, without the status
+// and the metadata set by the cluster
+func writeManifest(out io.Writer, kind string, o runtime.Object) error {
+	b, err := json.Marshal(o)
+	if err != nil {
+		return err
+	}
+
+	manifest := map[string]interface{}{}
+	if err := json.Unmarshal(b, &manifest); err != nil {
+		return err
+	}
+
+	manifest["apiVersion"] = v1alpha1.SchemeGroupVersion.String()
+	manifest["kind"] = kindNames[kind]
+	delete(manifest, "status")
+	manifest["metadata"] = cleanMetadata(manifest["metadata"])
+
+	y, err := yaml.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(out, "---\n%s", y)
+	return err
+}
+
+var kindNames = map[string]string{
+	"clustertask":      "ClusterTask",
+	"task":             "Task",
+	"condition":        "Condition",
+	"pipelineresource": "PipelineResource",
+	"pipeline":         "Pipeline",
+	"pipelinerun":      "PipelineRun",
+	"taskrun":          "TaskRun",
+}
+
+// cleanMetadata keeps the name, labels and annotations of the metadata,
+// the namespace is left out so that the manifests can be created in any
+// namespace
+func cleanMetadata(m interface{}) map[string]interface{} {
+	metadata, _ := m.(map[string]interface{})
+	clean := map[string]interface{}{"name": metadata["name"]}
+
+	if labels, ok := metadata["labels"]; ok {
+		clean["labels"] = labels
+	}
+	if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+		delete(annotations, lastAppliedConfig)
+		if len(annotations) > 0 {
+			clean["annotations"] = annotations
+		}
+	}
+	return clean
+}
+
+func contains(kinds []string, kind string) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
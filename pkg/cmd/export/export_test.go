@@ -0,0 +1,192 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	tb "github.com/tektoncd/pipeline/test/builder"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExport(t *testing.T) {
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	task := tb.Task("build", "ns", tb.TaskSpec(tb.Step("build", "busybox")))
+	task.UID = "c3b2a1"
+	task.ResourceVersion = "42"
+	task.Annotations = map[string]string{
+		lastAppliedConfig: "{}",
+		"owner":           "ci",
+	}
+
+	pipeline := tb.Pipeline("release", "ns",
+		tb.PipelineSpec(tb.PipelineTask("build", "build")),
+	)
+	pipeline.Labels = map[string]string{"app": "release"}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		Namespaces:   ns,
+		ClusterTasks: []*v1alpha1.ClusterTask{tb.ClusterTask("lint", tb.ClusterTaskSpec(tb.Step("lint", "golint")))},
+		Tasks:        []*v1alpha1.Task{task},
+		Pipelines:    []*v1alpha1.Pipeline{pipeline},
+		PipelineResources: []*v1alpha1.PipelineResource{
+			tb.PipelineResource("source", "ns", tb.PipelineResourceSpec(v1alpha1.PipelineResourceTypeGit,
+				tb.PipelineResourceSpecParam("url", "https://github.com/tektoncd/cli"),
+			)),
+		},
+		PipelineRuns: []*v1alpha1.PipelineRun{
+			tb.PipelineRun("release-run", "ns", tb.PipelineRunSpec("release"),
+				tb.PipelineRunStatus(tb.PipelineRunStartTime(metav1.Now().Time)),
+			),
+		},
+		TaskRuns: []*v1alpha1.TaskRun{
+			tb.TaskRun("build-run", "ns", tb.TaskRunSpec(tb.TaskRunTaskRef("build"))),
+			tb.TaskRun("release-run-build", "ns",
+				tb.TaskRunLabel("tekton.dev/pipelineRun", "release-run"),
+				tb.TaskRunSpec(tb.TaskRunTaskRef("build")),
+			),
+		},
+	})
+
+	tests := []struct {
+		name      string
+		command   []string
+		wantError bool
+		want      string
+	}{
+		{
+			name:      "invalid kind",
+			command:   []string{"-n", "ns", "--kinds", "task,foo"},
+			wantError: true,
+			want:      "invalid kind \"foo\", must be one of: clustertask|task|condition|pipelineresource|pipeline",
+		},
+		{
+			name:      "invalid namespace",
+			command:   []string{"-n", "invalid"},
+			wantError: true,
+			want:      "namespaces \"invalid\" not found",
+		},
+		{
+			name:    "only some kinds",
+			command: []string{"-n", "ns", "--kinds", "pipeline,task"},
+			want: `---
+apiVersion: tekton.dev/v1alpha1
+kind: Task
+metadata:
+  annotations:
+    owner: ci
+  name: build
+spec:
+  steps:
+  - image: busybox
+    name: build
+    resources: {}
+---
+apiVersion: tekton.dev/v1alpha1
+kind: Pipeline
+metadata:
+  labels:
+    app: release
+  name: release
+spec:
+  tasks:
+  - name: build
+    taskRef:
+      name: build
+`,
+		},
+		{
+			name:    "all kinds with runs",
+			command: []string{"-n", "ns", "--kinds", "clustertask,pipelineresource", "--include-runs"},
+			want: `---
+apiVersion: tekton.dev/v1alpha1
+kind: ClusterTask
+metadata:
+  name: lint
+spec:
+  steps:
+  - image: golint
+    name: lint
+    resources: {}
+---
+apiVersion: tekton.dev/v1alpha1
+kind: PipelineResource
+metadata:
+  name: source
+spec:
+  params:
+  - name: url
+    value: https://github.com/tektoncd/cli
+  type: git
+---
+apiVersion: tekton.dev/v1alpha1
+kind: PipelineRun
+metadata:
+  name: release-run
+spec:
+  pipelineRef:
+    name: release
+  podTemplate: {}
+  timeout: 1h0m0s
+---
+apiVersion: tekton.dev/v1alpha1
+kind: TaskRun
+metadata:
+  name: build-run
+spec:
+  inputs: {}
+  outputs: {}
+  podTemplate: {}
+  serviceAccountName: ""
+  taskRef:
+    name: build
+  timeout: 1h0m0s
+`,
+		},
+	}
+
+	for _, td := range tests {
+		t.Run(td.name, func(t *testing.T) {
+			p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+			got, err := test.ExecuteCommand(Command(p), td.command...)
+			if td.wantError {
+				if err == nil {
+					t.Fatalf("error expected here")
+				}
+				test.AssertOutput(t, td.want, err.Error())
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if strings.Contains(got, "status") || strings.Contains(got, "namespace") {
+				t.Errorf("cluster managed fields exported: %s", got)
+			}
+			test.AssertOutput(t, td.want, got)
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
 	"github.com/tektoncd/cli/pkg/helper/options"
 	validate "github.com/tektoncd/cli/pkg/helper/validate"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,12 +34,15 @@ func deleteCommand(p cli.Params) *cobra.Command {
 tkn pipeline delete foo -n bar
 
 tkn p rm foo -n bar
+
+# Delete the Pipelines 'foo' and 'baz' along with their PipelineRuns, waiting for them to be gone
+tkn p rm foo baz -n bar -a --wait
 `
 
 	c := &cobra.Command{
 		Use:          "delete",
 		Aliases:      []string{"rm"},
-		Short:        "Delete a pipeline in a namespace",
+		Short:        "Delete pipelines in a namespace",
 		Example:      eg,
 		Args:         cobra.MinimumNArgs(1),
 		SilenceUsage: true,
@@ -52,22 +56,29 @@ tkn p rm foo -n bar
 				Err: cmd.OutOrStderr(),
 			}
 
+			if err := opts.ValidateCascade(); err != nil {
+				return err
+			}
+
 			if err := validate.NamespaceExists(p); err != nil {
 				return err
 			}
 
-			if err := opts.CheckOptions(s, args[0]); err != nil {
+			if err := opts.CheckOptions(s, args...); err != nil {
 				return err
 			}
 
-			return deletePipeline(opts, s, p, args[0])
+			return opts.DeleteEach(s, args, func(name string) error {
+				return deletePipeline(opts, s, p, name)
+			})
 		},
 	}
 	f.AddFlags(c)
 	c.Flags().BoolVarP(&opts.ForceDelete, "force", "f", false, "Whether to force deletion (default: false)")
+	opts.AddCascadeFlags(c)
 	c.Flags().BoolVarP(&opts.DeleteAll, "all", "a", false, "Whether to delete related resources (pipelineruns) (default: false)")
 
-	_ = c.MarkZshCompPositionalArgumentCustom(1, "__tkn_get_pipeline")
+	completion.RegisterArgs(c, 0, "pipeline")
 	return c
 }
 
@@ -77,9 +88,15 @@ func deletePipeline(opts *options.DeleteOptions, s *cli.Stream, p cli.Params, pN
 		return fmt.Errorf("failed to create tekton client")
 	}
 
-	if err := cs.Tekton.TektonV1alpha1().Pipelines(p.Namespace()).Delete(pName, &metav1.DeleteOptions{}); err != nil {
+	if err := cs.Tekton.TektonV1alpha1().Pipelines(p.Namespace()).Delete(pName, opts.KubeDeleteOptions()); err != nil {
 		return fmt.Errorf("failed to delete pipeline %q: %s", pName, err)
 	}
+	if err := opts.WaitDeleted(func() error {
+		_, err := cs.Tekton.TektonV1alpha1().Pipelines(p.Namespace()).Get(pName, metav1.GetOptions{})
+		return err
+	}); err != nil {
+		return fmt.Errorf("failed to wait for pipeline %q to be deleted: %s", pName, err)
+	}
 	fmt.Fprintf(s.Out, "Pipeline deleted: %s\n", pName)
 
 	if !opts.DeleteAll {
@@ -96,7 +113,7 @@ func deletePipeline(opts *options.DeleteOptions, s *cli.Stream, p cli.Params, pN
 	}
 
 	for _, pr := range pipelineRuns.Items {
-		if err := cs.Tekton.TektonV1alpha1().PipelineRuns(p.Namespace()).Delete(pr.Name, &metav1.DeleteOptions{}); err != nil {
+		if err := cs.Tekton.TektonV1alpha1().PipelineRuns(p.Namespace()).Delete(pr.Name, opts.KubeDeleteOptions()); err != nil {
 			return fmt.Errorf("failed to delete pipelinerun %q: %s", pr.Name, err)
 		}
 
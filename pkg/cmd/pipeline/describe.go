@@ -17,16 +17,22 @@ package pipeline
 import (
 	"fmt"
 	"io"
+	"os"
 	"sort"
+	"strings"
 	"text/tabwriter"
 	"text/template"
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
 	"github.com/tektoncd/cli/pkg/formatted"
 	validate "github.com/tektoncd/cli/pkg/helper/validate"
+	"github.com/tektoncd/cli/pkg/printer"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	cliopts "k8s.io/cli-runtime/pkg/genericclioptions"
 )
 
@@ -63,6 +69,8 @@ NAME	TYPE	DEFAULT VALUE
 Tasks
 {{- $tl := len .Pipeline.Spec.Tasks }}{{ if eq $tl 0 }}
 No tasks
+{{- else if .TaskRefs }}
+{{- template "tasksDetail" . }}
 {{- else }}
 NAME	TASKREF	RUNAFTER
 {{- range $i, $t := .Pipeline.Spec.Tasks }}
@@ -81,8 +89,57 @@ NAME	STARTED	DURATION	STATUS
 {{- end }}
 `
 
+// tasksDetailTemplate lists everything a task of the pipeline is given with
+// --tasks-detail
+const tasksDetailTemplate = `{{ define "tasksDetail" }}
+{{- range $i, $t := .Pipeline.Spec.Tasks }}
+
+{{ $t.Name }}
+ Task Ref:	{{ index $.TaskRefs $t.Name }}
+ Run After:	{{ if $t.RunAfter }}{{ join $t.RunAfter }}{{ else }}---{{ end }}
+ Retries:	{{ $t.Retries }}
+ Params
+{{- $pl := len $t.Params }}{{ if eq $pl 0 }}
+  No params
+{{- else }}
+  NAME	VALUE
+{{- range $p := $t.Params }}
+  {{ $p.Name }}	{{ formatParamValue $p.Value }}
+{{- end }}
+{{- end }}
+ Input Resources
+{{- if not $t.Resources.Inputs }}
+  No input resources
+{{- else }}
+  NAME	RESOURCE	FROM
+{{- range $r := $t.Resources.Inputs }}
+  {{ $r.Name }}	{{ $r.Resource }}	{{ if $r.From }}{{ join $r.From }}{{ else }}---{{ end }}
+{{- end }}
+{{- end }}
+ Output Resources
+{{- if not $t.Resources.Outputs }}
+  No output resources
+{{- else }}
+  NAME	RESOURCE
+{{- range $r := $t.Resources.Outputs }}
+  {{ $r.Name }}	{{ $r.Resource }}
+{{- end }}
+{{- end }}
+ Conditions
+{{- $cl := len $t.Conditions }}{{ if eq $cl 0 }}
+  No conditions
+{{- else }}
+  NAME	PARAMS	RESOURCES
+{{- range $c := $t.Conditions }}
+  {{ $c.ConditionRef }}	{{ formatConditionParams $c.Params }}	{{ formatConditionResources $c.Resources }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- end }}`
+
 func describeCommand(p cli.Params) *cobra.Command {
 	f := cliopts.NewPrintFlags("describe")
+	tasksDetail := false
 
 	c := &cobra.Command{
 		Use:     "describe",
@@ -99,16 +156,49 @@ func describeCommand(p cli.Params) *cobra.Command {
 				return err
 			}
 
-			return printPipelineDescription(cmd.OutOrStdout(), p, args[0])
+			output, err := cmd.LocalFlags().GetString("output")
+			if err != nil {
+				fmt.Fprint(os.Stderr, "Error: output option not set properly \n")
+				return err
+			}
+
+			if output != "" {
+				return printPipelineObj(cmd.OutOrStdout(), p, args[0], f)
+			}
+
+			return printPipelineDescription(cmd.OutOrStdout(), p, args[0], f, tasksDetail)
 		},
 	}
+	c.Flags().BoolVarP(&tasksDetail, "tasks-detail", "", false, "describe the params, resources, conditions and retries of every task, and whether the tasks they refer to exist")
 
-	_ = c.MarkZshCompPositionalArgumentCustom(1, "__tkn_get_pipeline")
+	completion.RegisterArgs(c, 1, "pipeline")
 	f.AddFlags(c)
 	return c
 }
 
-func printPipelineDescription(out io.Writer, p cli.Params, pname string) error {
+func printPipelineObj(w io.Writer, p cli.Params, pname string, f *cliopts.PrintFlags) error {
+	cs, err := p.Clients()
+	if err != nil {
+		return err
+	}
+
+	pipeline, err := cs.Tekton.TektonV1alpha1().Pipelines(p.Namespace()).Get(pname, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	// NOTE: this is required for -o json|yaml to work properly since
+	// tektoncd go client fails to set these; probably a bug
+	pipeline.GetObjectKind().SetGroupVersionKind(
+		schema.GroupVersionKind{
+			Version: "tekton.dev/v1alpha1",
+			Kind:    "Pipeline",
+		})
+
+	return printer.PrintObject(w, pipeline, f)
+}
+
+func printPipelineDescription(out io.Writer, p cli.Params, pname string, f *cliopts.PrintFlags, tasksDetail bool) error {
 	cs, err := p.Clients()
 	if err != nil {
 		return err
@@ -131,26 +221,55 @@ func printPipelineDescription(out io.Writer, p cli.Params, pname string) error {
 		return err
 	}
 
+	var taskRefs map[string]string
+	if tasksDetail {
+		if taskRefs, err = describeTaskRefs(cs, p.Namespace(), pipeline.Spec.Tasks); err != nil {
+			return err
+		}
+		for i := range pipeline.Spec.Tasks {
+			if pipeline.Spec.Tasks[i].Resources == nil {
+				pipeline.Spec.Tasks[i].Resources = &v1alpha1.PipelineTaskResources{}
+			}
+		}
+	}
+
 	var data = struct {
 		Pipeline     *v1alpha1.Pipeline
 		PipelineRuns *v1alpha1.PipelineRunList
 		PipelineName string
 		Params       cli.Params
+		// TaskRefs describes the task referred to by each pipeline task
+		// with --tasks-detail, it is nil otherwise
+		TaskRefs map[string]string
 	}{
 		Pipeline:     pipeline,
 		PipelineRuns: pipelineRuns,
 		PipelineName: pname,
 		Params:       p,
+		TaskRefs:     taskRefs,
 	}
 
 	funcMap := template.FuncMap{
-		"formatAge":       formatted.Age,
-		"formatDuration":  formatted.Duration,
-		"formatCondition": formatted.Condition,
+		"formatAge":                formatted.Age,
+		"formatDuration":           formatted.Duration,
+		"formatCondition":          formatted.Condition,
+		"formatParamValue":         formatParamValue,
+		"formatConditionParams":    formatConditionParams,
+		"formatConditionResources": formatConditionResources,
+		"join":                     func(s []string) string { return strings.Join(s, ", ") },
+	}
+
+	tmpl, err := printer.DescribeTemplate(f, describeTemplate)
+	if err != nil {
+		return err
+	}
+
+	t, err := template.New("Describe Pipeline").Funcs(funcMap).Parse(tmpl + tasksDetailTemplate)
+	if err != nil {
+		return err
 	}
 
 	w := tabwriter.NewWriter(out, 0, 5, 3, ' ', tabwriter.TabIndent)
-	t := template.Must(template.New("Describe Pipeline").Funcs(funcMap).Parse(describeTemplate))
 	err = t.Execute(w, data)
 	if err != nil {
 		return err
@@ -175,3 +294,63 @@ func sortResourcesByTypeAndName(pres []v1alpha1.PipelineDeclaredResource) []v1al
 
 	return pres
 }
+
+// describeTaskRefs returns the name and kind of the task each pipeline task
+// refers to, along with whether it could not be found
+func describeTaskRefs(cs *cli.Clients, ns string, tasks []v1alpha1.PipelineTask) (map[string]string, error) {
+	refs := map[string]string{}
+	for _, t := range tasks {
+		kind := t.TaskRef.Kind
+		if kind == "" {
+			kind = v1alpha1.NamespacedTaskKind
+		}
+
+		var err error
+		if kind == v1alpha1.ClusterTaskKind {
+			_, err = cs.Tekton.TektonV1alpha1().ClusterTasks().Get(t.TaskRef.Name, metav1.GetOptions{})
+		} else {
+			_, err = cs.Tekton.TektonV1alpha1().Tasks(ns).Get(t.TaskRef.Name, metav1.GetOptions{})
+		}
+
+		switch {
+		case errors.IsNotFound(err):
+			refs[t.Name] = fmt.Sprintf("%s (%s, not found)", t.TaskRef.Name, kind)
+		case err != nil:
+			return nil, fmt.Errorf("failed to get %s %s of task %s: %s", kind, t.TaskRef.Name, t.Name, err)
+		default:
+			refs[t.Name] = fmt.Sprintf("%s (%s)", t.TaskRef.Name, kind)
+		}
+	}
+	return refs, nil
+}
+
+func formatParamValue(v v1alpha1.ArrayOrString) string {
+	if v.Type == v1alpha1.ParamTypeArray {
+		return fmt.Sprint(v.ArrayVal)
+	}
+	return v.StringVal
+}
+
+func formatConditionParams(params []v1alpha1.Param) string {
+	if len(params) == 0 {
+		return "---"
+	}
+
+	s := []string{}
+	for _, p := range params {
+		s = append(s, p.Name+"="+formatParamValue(p.Value))
+	}
+	return strings.Join(s, ", ")
+}
+
+func formatConditionResources(resources []v1alpha1.PipelineConditionResource) string {
+	if len(resources) == 0 {
+		return "---"
+	}
+
+	s := []string{}
+	for _, r := range resources {
+		s = append(s, r.Name+"="+r.Resource)
+	}
+	return strings.Join(s, ", ")
+}
@@ -429,3 +429,142 @@ func TestPipelinesDescribe_with_multiple_resource_param_task_run(t *testing.T) {
 		t.Errorf("Unexpected output mismatch: \n%s\n", d)
 	}
 }
+
+func TestPipelinesDescribe_output(t *testing.T) {
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		Pipelines: []*v1alpha1.Pipeline{
+			tb.Pipeline("pipeline", "ns",
+				tb.PipelineSpec(
+					tb.PipelineTask("task", "taskref"),
+				),
+			),
+		},
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ns",
+				},
+			},
+		},
+	})
+
+	testParams := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "jsonpath output",
+			args:     []string{"desc", "pipeline", "-n", "ns", "-o", "jsonpath={.kind}/{.metadata.name}/{.spec.tasks[0].taskRef.name}"},
+			expected: "Pipeline/pipeline/taskref",
+		},
+		{
+			name:     "custom text template",
+			args:     []string{"desc", "pipeline", "-n", "ns", "--template", "{{ .PipelineName }}: {{ len .Pipeline.Spec.Tasks }} task(s)\n"},
+			expected: "pipeline: 1 task(s)\n",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+			pipeline := Command(p)
+			got, err := test.ExecuteCommand(pipeline, tp.args...)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			test.AssertOutput(t, tp.expected, got)
+		})
+	}
+}
+
+func TestPipelinesDescribe_tasks_detail(t *testing.T) {
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		Pipelines: []*v1alpha1.Pipeline{
+			tb.Pipeline("pipeline", "ns",
+				tb.PipelineSpec(
+					tb.PipelineTask("fetch", "git-clone",
+						tb.PipelineTaskParam("url", "https://github.com/tektoncd/cli"),
+						tb.PipelineTaskParam("flags", "--depth", "1"),
+						tb.PipelineTaskOutputResource("source", "repo"),
+					),
+					tb.PipelineTask("build", "buildah",
+						tb.PipelineTaskRefKind(v1alpha1.ClusterTaskKind),
+						tb.RunAfter("fetch"),
+						tb.Retries(2),
+						tb.PipelineTaskInputResource("source", "repo", tb.From("fetch")),
+						tb.PipelineTaskCondition("has-dockerfile",
+							tb.PipelineTaskConditionParam("path", "Dockerfile"),
+							tb.PipelineTaskConditionResource("workspace", "repo"),
+						),
+					),
+				),
+			),
+		},
+		Tasks: []*v1alpha1.Task{
+			tb.Task("git-clone", "ns"),
+		},
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ns",
+				},
+			},
+		},
+	})
+
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+	pipeline := Command(p)
+	got, err := test.ExecuteCommand(pipeline, "desc", "pipeline", "-n", "ns", "--tasks-detail")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	expected := `Name:   pipeline
+
+Resources
+No resources
+
+Params
+No params
+
+Tasks
+
+fetch
+ Task Ref:    git-clone (Task)
+ Run After:   ---
+ Retries:     0
+ Params
+  NAME    VALUE
+  url     https://github.com/tektoncd/cli
+  flags   [--depth 1]
+ Input Resources
+  No input resources
+ Output Resources
+  NAME     RESOURCE
+  source   repo
+ Conditions
+  No conditions
+
+build
+ Task Ref:    buildah (ClusterTask, not found)
+ Run After:   fetch
+ Retries:     2
+ Params
+  No params
+ Input Resources
+  NAME     RESOURCE   FROM
+  source   repo       fetch
+ Output Resources
+  No output resources
+ Conditions
+  NAME             PARAMS            RESOURCES
+  has-dockerfile   path=Dockerfile   workspace=repo
+
+Pipelineruns
+No pipelineruns
+`
+	if d := cmp.Diff(expected, got); d != "" {
+		t.Errorf("Unexpected output mismatch: \n%s\n", d)
+	}
+}
@@ -0,0 +1,309 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
+	"github.com/tektoncd/cli/pkg/formatted"
+	phelper "github.com/tektoncd/cli/pkg/helper/pipeline"
+	validate "github.com/tektoncd/cli/pkg/helper/validate"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	graphFormatASCII   = "ascii"
+	graphFormatDot     = "dot"
+	graphFormatMermaid = "mermaid"
+)
+
+type graphOptions struct {
+	Format      string
+	PipelineRun string
+	Last        bool
+}
+
+func graphCommand(p cli.Params) *cobra.Command {
+	opts := &graphOptions{}
+	eg := `
+# Print the task graph of the Pipeline 'foo' as a tree in the terminal
+tkn pipeline graph foo -n bar
+
+# Render the task graph of the Pipeline 'foo' with Graphviz
+tkn pipeline graph foo -n bar --format dot | dot -Tpng > foo.png
+
+# Colour the tasks of the Pipeline 'foo' with the status of its last run
+tkn pipeline graph foo -n bar --last --format mermaid
+`
+
+	c := &cobra.Command{
+		Use:          "graph",
+		Short:        "Show the graph of the tasks of a pipeline",
+		Example:      eg,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validate.NamespaceExists(p); err != nil {
+				return err
+			}
+
+			return printPipelineGraph(cmd.OutOrStdout(), p, args[0], opts)
+		},
+	}
+
+	c.Flags().StringVarP(&opts.Format, "format", "", graphFormatASCII, "format of the graph, one of: ascii|dot|mermaid")
+	c.Flags().StringVarP(&opts.PipelineRun, "pipelinerun", "", "", "colour the tasks with the status of the given pipelinerun")
+	c.Flags().BoolVarP(&opts.Last, "last", "L", false, "colour the tasks with the status of the last pipelinerun")
+
+	completion.RegisterArgs(c, 1, "pipeline")
+	completion.RegisterFlag(c, "pipelinerun", "pipelinerun")
+	return c
+}
+
+func printPipelineGraph(out io.Writer, p cli.Params, pname string, opts *graphOptions) error {
+	if opts.Last && opts.PipelineRun != "" {
+		return fmt.Errorf("--last and --pipelinerun can not be used together")
+	}
+
+	cs, err := p.Clients()
+	if err != nil {
+		return err
+	}
+
+	pipeline, err := cs.Tekton.TektonV1alpha1().Pipelines(p.Namespace()).Get(pname, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	g, err := phelper.BuildGraph(pipeline)
+	if err != nil {
+		return fmt.Errorf("failed to build the graph of pipeline %s: %v", pname, err)
+	}
+
+	var pr *v1alpha1.PipelineRun
+	switch {
+	case opts.Last:
+		pr, err = phelper.LastRun(cs.Tekton, pname, p.Namespace())
+	case opts.PipelineRun != "":
+		pr, err = cs.Tekton.TektonV1alpha1().PipelineRuns(p.Namespace()).Get(opts.PipelineRun, metav1.GetOptions{})
+		if err == nil && validate.PipelineRefExists(pr.Spec) != pname {
+			err = fmt.Errorf("pipelinerun %s is not a run of pipeline %s", opts.PipelineRun, pname)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	statuses := taskStatuses(pr)
+
+	switch opts.Format {
+	case graphFormatASCII:
+		printGraphASCII(out, pname, g, statuses)
+	case graphFormatDot:
+		printGraphDot(out, pname, g, statuses)
+	case graphFormatMermaid:
+		printGraphMermaid(out, g, statuses)
+	default:
+		return fmt.Errorf("invalid graph format %q, must be one of: ascii|dot|mermaid", opts.Format)
+	}
+	return nil
+}
+
+// taskStatuses returns the status of the taskruns of a pipelinerun keyed by
+// the name of their pipeline task
+func taskStatuses(pr *v1alpha1.PipelineRun) map[string]string {
+	statuses := map[string]string{}
+	if pr == nil {
+		return statuses
+	}
+
+	for _, trs := range pr.Status.TaskRuns {
+		if trs.Status == nil {
+			continue
+		}
+		statuses[trs.PipelineTaskName] = formatted.Condition(trs.Status.Conditions)
+	}
+	return statuses
+}
+
+// statusClass reduces a formatted condition like "Failed(TaskRunCancelled)"
+// to one of succeeded, failed or running; returns an empty string otherwise
+func statusClass(status string) string {
+	switch {
+	case strings.HasPrefix(status, "Succeeded"):
+		return "succeeded"
+	case strings.HasPrefix(status, "Failed"):
+		return "failed"
+	case strings.HasPrefix(status, "Running"):
+		return "running"
+	}
+	return ""
+}
+
+func conditionRefs(t v1alpha1.PipelineTask) []string {
+	refs := []string{}
+	for _, c := range t.Conditions {
+		refs = append(refs, c.ConditionRef)
+	}
+	return refs
+}
+
+func printGraphASCII(out io.Writer, pname string, g *phelper.Graph, statuses map[string]string) {
+	tasks := map[string]v1alpha1.PipelineTask{}
+	for _, t := range g.Tasks {
+		tasks[t.Name] = t
+	}
+
+	colours := map[string]*color.Color{
+		"succeeded": color.New(color.FgGreen),
+		"failed":    color.New(color.FgRed),
+		"running":   color.New(color.FgBlue),
+	}
+
+	label := func(name string) string {
+		s := name
+		if clr, ok := colours[statusClass(statuses[name])]; ok {
+			s = clr.Sprint(name)
+		}
+		if status, ok := statuses[name]; ok {
+			s += " (" + status + ")"
+		}
+		if refs := conditionRefs(tasks[name]); len(refs) > 0 {
+			s += " [when: " + strings.Join(refs, ", ") + "]"
+		}
+		return s
+	}
+
+	printed := map[string]bool{}
+	var walk func(name, prefix string, last bool)
+	walk = func(name, prefix string, last bool) {
+		branch, indent := "├── ", "│   "
+		if last {
+			branch, indent = "└── ", "    "
+		}
+
+		// a task depending on several tasks is only expanded the first time
+		if printed[name] {
+			fmt.Fprintf(out, "%s%s%s (see above)\n", prefix, branch, name)
+			return
+		}
+		printed[name] = true
+		fmt.Fprintf(out, "%s%s%s\n", prefix, branch, label(name))
+
+		next := g.Next(name)
+		for i, e := range next {
+			walk(e.To, prefix+indent, i == len(next)-1)
+		}
+	}
+
+	fmt.Fprintln(out, pname)
+	roots := g.Roots()
+	if len(roots) == 0 {
+		fmt.Fprintln(out, "No tasks")
+		return
+	}
+	for i, r := range roots {
+		walk(r, "", i == len(roots)-1)
+	}
+}
+
+func printGraphDot(out io.Writer, pname string, g *phelper.Graph, statuses map[string]string) {
+	fills := map[string]string{
+		"succeeded": "palegreen",
+		"failed":    "salmon",
+		"running":   "lightskyblue",
+	}
+
+	fmt.Fprintf(out, "digraph %q {\n", pname)
+	fmt.Fprintln(out, "  rankdir=LR;")
+	fmt.Fprintln(out, "  node [shape=box];")
+	for _, t := range g.Tasks {
+		label := t.Name
+		if status, ok := statuses[t.Name]; ok {
+			label += "\\n" + status
+		}
+		if fill, ok := fills[statusClass(statuses[t.Name])]; ok {
+			fmt.Fprintf(out, "  %q [label=\"%s\", style=filled, fillcolor=%s];\n", t.Name, label, fill)
+		} else {
+			fmt.Fprintf(out, "  %q [label=\"%s\"];\n", t.Name, label)
+		}
+
+		for _, ref := range conditionRefs(t) {
+			id := t.Name + "/" + ref
+			fmt.Fprintf(out, "  %q [label=%q, shape=diamond];\n", id, ref)
+			fmt.Fprintf(out, "  %q -> %q [style=dashed];\n", id, t.Name)
+		}
+	}
+	for _, e := range g.Edges {
+		if e.Kind == phelper.EdgeFrom {
+			fmt.Fprintf(out, "  %q -> %q [label=%q];\n", e.From, e.To, e.Resource)
+			continue
+		}
+		fmt.Fprintf(out, "  %q -> %q;\n", e.From, e.To)
+	}
+	fmt.Fprintln(out, "}")
+}
+
+var mermaidInvalidID = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+func mermaidID(name string) string {
+	return mermaidInvalidID.ReplaceAllString(name, "_")
+}
+
+func printGraphMermaid(out io.Writer, g *phelper.Graph, statuses map[string]string) {
+	fmt.Fprintln(out, "graph LR")
+	for _, t := range g.Tasks {
+		label := t.Name
+		if status, ok := statuses[t.Name]; ok {
+			label += "<br/>" + status
+		}
+		fmt.Fprintf(out, "  %s[\"%s\"]\n", mermaidID(t.Name), label)
+
+		for _, ref := range conditionRefs(t) {
+			id := mermaidID(t.Name + "_" + ref)
+			fmt.Fprintf(out, "  %s{\"%s\"} -.-> %s\n", id, ref, mermaidID(t.Name))
+		}
+	}
+	for _, e := range g.Edges {
+		if e.Kind == phelper.EdgeFrom {
+			fmt.Fprintf(out, "  %s -->|%s| %s\n", mermaidID(e.From), e.Resource, mermaidID(e.To))
+			continue
+		}
+		fmt.Fprintf(out, "  %s --> %s\n", mermaidID(e.From), mermaidID(e.To))
+	}
+
+	if len(statuses) == 0 {
+		return
+	}
+	fmt.Fprintln(out, "  classDef succeeded fill:#9f9")
+	fmt.Fprintln(out, "  classDef failed fill:#f99")
+	fmt.Fprintln(out, "  classDef running fill:#9cf")
+	for _, t := range g.Tasks {
+		if class := statusClass(statuses[t.Name]); class != "" {
+			fmt.Fprintf(out, "  class %s %s\n", mermaidID(t.Name), class)
+		}
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	tb "github.com/tektoncd/pipeline/test/builder"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func graphTestData(t *testing.T) pipelinetest.Clients {
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		Pipelines: []*v1alpha1.Pipeline{
+			tb.Pipeline("pipeline", "ns",
+				tb.PipelineSpec(
+					tb.PipelineDeclaredResource("git-repo", "git"),
+					tb.PipelineDeclaredResource("image", "image"),
+					tb.PipelineTask("fetch", "git-clone"),
+					tb.PipelineTask("build", "buildah",
+						tb.PipelineTaskInputResource("source", "git-repo", tb.From("fetch")),
+						tb.PipelineTaskOutputResource("image", "image"),
+					),
+					tb.PipelineTask("test", "unit-tests", tb.RunAfter("fetch")),
+					tb.PipelineTask("deploy", "kubectl",
+						tb.RunAfter("build", "test"),
+						tb.PipelineTaskCondition("is-main-branch"),
+					),
+				),
+			),
+		},
+		PipelineRuns: []*v1alpha1.PipelineRun{
+			tb.PipelineRun("pipeline-run", "ns",
+				tb.PipelineRunLabel("tekton.dev/pipeline", "pipeline"),
+				tb.PipelineRunSpec("pipeline"),
+				tb.PipelineRunStatus(
+					tb.PipelineRunTaskRunsStatus("tr-fetch", &v1alpha1.PipelineRunTaskRunStatus{
+						PipelineTaskName: "fetch",
+						Status:           taskRunStatus(corev1.ConditionTrue),
+					}),
+					tb.PipelineRunTaskRunsStatus("tr-build", &v1alpha1.PipelineRunTaskRunStatus{
+						PipelineTaskName: "build",
+						Status:           taskRunStatus(corev1.ConditionFalse),
+					}),
+					tb.PipelineRunTaskRunsStatus("tr-test", &v1alpha1.PipelineRunTaskRunStatus{
+						PipelineTaskName: "test",
+						Status:           taskRunStatus(corev1.ConditionUnknown),
+					}),
+				),
+			),
+			tb.PipelineRun("other-run", "ns",
+				tb.PipelineRunLabel("tekton.dev/pipeline", "other"),
+				tb.PipelineRunSpec("other"),
+			),
+		},
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ns",
+				},
+			},
+		},
+	})
+	return cs
+}
+
+func TestPipelineGraph_ascii(t *testing.T) {
+	cs := graphTestData(t)
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+
+	pipeline := Command(p)
+	got, err := test.ExecuteCommand(pipeline, "graph", "pipeline", "-n", "ns")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	expected := `pipeline
+└── fetch
+    ├── build
+    │   └── deploy [when: is-main-branch]
+    └── test
+        └── deploy (see above)
+`
+	test.AssertOutput(t, expected, got)
+}
+
+func TestPipelineGraph_ascii_with_run(t *testing.T) {
+	cs := graphTestData(t)
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+
+	pipeline := Command(p)
+	got, err := test.ExecuteCommand(pipeline, "graph", "pipeline", "-n", "ns", "--pipelinerun", "pipeline-run")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	expected := `pipeline
+└── fetch (Succeeded)
+    ├── build (Failed)
+    │   └── deploy [when: is-main-branch]
+    └── test (Running)
+        └── deploy (see above)
+`
+	test.AssertOutput(t, expected, got)
+}
+
+func TestPipelineGraph_dot(t *testing.T) {
+	cs := graphTestData(t)
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+
+	pipeline := Command(p)
+	got, err := test.ExecuteCommand(pipeline, "graph", "pipeline", "-n", "ns", "--format", "dot", "--pipelinerun", "pipeline-run")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	expected := `digraph "pipeline" {
+  rankdir=LR;
+  node [shape=box];
+  "fetch" [label="fetch\nSucceeded", style=filled, fillcolor=palegreen];
+  "build" [label="build\nFailed", style=filled, fillcolor=salmon];
+  "test" [label="test\nRunning", style=filled, fillcolor=lightskyblue];
+  "deploy" [label="deploy"];
+  "deploy/is-main-branch" [label="is-main-branch", shape=diamond];
+  "deploy/is-main-branch" -> "deploy" [style=dashed];
+  "fetch" -> "build" [label="git-repo"];
+  "fetch" -> "test";
+  "build" -> "deploy";
+  "test" -> "deploy";
+}
+`
+	test.AssertOutput(t, expected, got)
+}
+
+func TestPipelineGraph_mermaid(t *testing.T) {
+	cs := graphTestData(t)
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+
+	pipeline := Command(p)
+	got, err := test.ExecuteCommand(pipeline, "graph", "pipeline", "-n", "ns", "--format", "mermaid")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	expected := `graph LR
+  fetch["fetch"]
+  build["build"]
+  test["test"]
+  deploy["deploy"]
+  deploy_is_main_branch{"is-main-branch"} -.-> deploy
+  fetch -->|git-repo| build
+  fetch --> test
+  build --> deploy
+  test --> deploy
+`
+	test.AssertOutput(t, expected, got)
+}
+
+func TestPipelineGraph_errors(t *testing.T) {
+	cs := graphTestData(t)
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+
+	testParams := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "invalid format",
+			args:     []string{"graph", "pipeline", "-n", "ns", "--format", "png"},
+			expected: "invalid graph format \"png\", must be one of: ascii|dot|mermaid",
+		},
+		{
+			name:     "run of another pipeline",
+			args:     []string{"graph", "pipeline", "-n", "ns", "--pipelinerun", "other-run"},
+			expected: "pipelinerun other-run is not a run of pipeline pipeline",
+		},
+		{
+			name:     "last and pipelinerun",
+			args:     []string{"graph", "pipeline", "-n", "ns", "--pipelinerun", "pipeline-run", "--last"},
+			expected: "--last and --pipelinerun can not be used together",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			pipeline := Command(p)
+			_, err := test.ExecuteCommand(pipeline, tp.args...)
+			if err == nil {
+				t.Fatalf("Expected error, got nil")
+			}
+			test.AssertOutput(t, tp.expected, err.Error())
+		})
+	}
+}
+
+func taskRunStatus(status corev1.ConditionStatus) *v1alpha1.TaskRunStatus {
+	tr := tb.TaskRun("tr", "ns",
+		tb.TaskRunStatus(
+			tb.StatusCondition(apis.Condition{
+				Type:   apis.ConditionSucceeded,
+				Status: status,
+			}),
+		),
+	)
+	return &tr.Status
+}
@@ -24,8 +24,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/cli/pkg/helper/options"
 	"github.com/tektoncd/cli/pkg/helper/pipeline"
-	validate "github.com/tektoncd/cli/pkg/helper/validate"
 	"github.com/tektoncd/cli/pkg/printer"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
 	"github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
@@ -37,19 +37,20 @@ import (
 const listTemplate = `{{- $pl := len .Pipelines.Items }}{{ if eq $pl 0 -}}
 No pipelines
 {{- else -}}
-NAME	AGE	LAST RUN	STARTED	DURATION	STATUS
+{{- if .AllNamespaces }}NAMESPACE	{{ end }}NAME	AGE	LAST RUN	STARTED	DURATION	STATUS
 {{- range $_, $p := .Pipelines.Items }}
-{{- $pr := accessMap $.PipelineRuns $p.Name }}
+{{- $pr := accessMap $.PipelineRuns $p }}
 {{- if $pr }}
-{{ $p.Name }}	{{ formatAge $p.CreationTimestamp $.Params.Time }}	{{ $pr.Name }}	{{ formatAge $pr.Status.StartTime $.Params.Time }}	{{ formatDuration $pr.Status.StartTime $pr.Status.CompletionTime }}	{{ formatCondition $pr.Status.Conditions }}
+{{ if $.AllNamespaces }}{{ $p.Namespace }}	{{ end }}{{ $p.Name }}	{{ formatAge $p.CreationTimestamp $.Params.Time }}	{{ $pr.Name }}	{{ formatAge $pr.Status.StartTime $.Params.Time }}	{{ formatDuration $pr.Status.StartTime $pr.Status.CompletionTime }}	{{ formatCondition $pr.Status.Conditions }}
 {{- else }}
-{{ $p.Name }}	{{ formatAge $p.CreationTimestamp $.Params.Time }}	---	---	---	---
+{{ if $.AllNamespaces }}{{ $p.Namespace }}	{{ end }}{{ $p.Name }}	{{ formatAge $p.CreationTimestamp $.Params.Time }}	---	---	---	---
 {{- end }}
 {{- end }}
 {{- end }}
 `
 
 func listCommand(p cli.Params) *cobra.Command {
+	opts := &options.ListOptions{}
 	f := cliopts.NewPrintFlags("list")
 
 	c := &cobra.Command{
@@ -62,7 +63,11 @@ func listCommand(p cli.Params) *cobra.Command {
 		SilenceUsage: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
 
-			if err := validate.NamespaceExists(p); err != nil {
+			if err := opts.ValidateNamespace(p); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
 				return err
 			}
 
@@ -73,28 +78,30 @@ func listCommand(p cli.Params) *cobra.Command {
 			}
 
 			if output != "" {
-				return printPipelineListObj(cmd.OutOrStdout(), p, f)
+				return printPipelineListObj(cmd.OutOrStdout(), p, f, opts)
 			}
 			stream := &cli.Stream{
 				Out: cmd.OutOrStdout(),
 				Err: cmd.OutOrStderr(),
 			}
-			return printPipelineDetails(stream, p)
+			return printPipelineDetails(stream, p, opts)
 		},
 	}
-	f.AddFlags(c)
+	printer.AddListFlags(c, f)
+	opts.AddFlags(c)
+	opts.AddAllNamespacesFlag(c)
 
 	return c
 }
 
-func printPipelineDetails(s *cli.Stream, p cli.Params) error {
+func printPipelineDetails(s *cli.Stream, p cli.Params, opts *options.ListOptions) error {
 
 	cs, err := p.Clients()
 	if err != nil {
 		return err
 	}
 
-	ps, prs, err := listPipelineDetails(cs, p.Namespace())
+	ps, prs, err := listPipelineDetails(cs, opts.Namespace(p), opts)
 
 	if err != nil {
 		fmt.Fprintf(s.Err, "Failed to list pipelines from %s namespace\n", p.Namespace())
@@ -102,18 +109,20 @@ func printPipelineDetails(s *cli.Stream, p cli.Params) error {
 	}
 
 	var data = struct {
-		Pipelines    *v1alpha1.PipelineList
-		PipelineRuns pipelineruns
-		Params       cli.Params
+		Pipelines     *v1alpha1.PipelineList
+		PipelineRuns  pipelineruns
+		Params        cli.Params
+		AllNamespaces bool
 	}{
-		Pipelines:    ps,
-		PipelineRuns: prs,
-		Params:       p,
+		Pipelines:     ps,
+		PipelineRuns:  prs,
+		Params:        p,
+		AllNamespaces: opts.AllNamespaces,
 	}
 
 	funcMap := template.FuncMap{
-		"accessMap": func(prs pipelineruns, name string) *v1alpha1.PipelineRun {
-			if pr, ok := prs[name]; ok {
+		"accessMap": func(prs pipelineruns, p v1alpha1.Pipeline) *v1alpha1.PipelineRun {
+			if pr, ok := prs[lastRunKey(p)]; ok {
 				return &pr
 			}
 
@@ -134,13 +143,13 @@ func printPipelineDetails(s *cli.Stream, p cli.Params) error {
 	return w.Flush()
 }
 
-func printPipelineListObj(w io.Writer, p cli.Params, f *cliopts.PrintFlags) error {
+func printPipelineListObj(w io.Writer, p cli.Params, f *cliopts.PrintFlags, opts *options.ListOptions) error {
 	cs, err := p.Clients()
 	if err != nil {
 		return err
 	}
 
-	ps, err := listAllPipelines(cs.Tekton, p.Namespace())
+	ps, err := listAllPipelines(cs.Tekton, opts.Namespace(p), opts)
 	if err != nil {
 		return err
 	}
@@ -148,14 +157,20 @@ func printPipelineListObj(w io.Writer, p cli.Params, f *cliopts.PrintFlags) erro
 	return printer.PrintObject(w, ps, f)
 }
 
-func listAllPipelines(cs versioned.Interface, ns string) (*v1alpha1.PipelineList, error) {
+func listAllPipelines(cs versioned.Interface, ns string, opts *options.ListOptions) (*v1alpha1.PipelineList, error) {
 	c := cs.TektonV1alpha1().Pipelines(ns)
 
-	ps, err := c.List(metav1.ListOptions{})
+	ps, err := c.List(metav1.ListOptions{
+		LabelSelector: opts.LabelSelector(),
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	opts.SortObjects(ps.Items, func(i int) metav1.Object {
+		return &ps.Items[i]
+	})
+
 	// NOTE: this is required for -o json|yaml to work properly since
 	// tektoncd go client fails to set these; probably a bug
 	ps.GetObjectKind().SetGroupVersionKind(
@@ -166,11 +181,17 @@ func listAllPipelines(cs versioned.Interface, ns string) (*v1alpha1.PipelineList
 	return ps, nil
 }
 
+// pipelineruns are the last runs of the pipelines keyed by the namespace and
+// name of the pipeline, as pipelines may be listed across namespaces
 type pipelineruns map[string]v1alpha1.PipelineRun
 
-func listPipelineDetails(cs *cli.Clients, ns string) (*v1alpha1.PipelineList, pipelineruns, error) {
+func lastRunKey(p v1alpha1.Pipeline) string {
+	return p.Namespace + "/" + p.Name
+}
+
+func listPipelineDetails(cs *cli.Clients, ns string, opts *options.ListOptions) (*v1alpha1.PipelineList, pipelineruns, error) {
 
-	ps, err := listAllPipelines(cs.Tekton, ns)
+	ps, err := listAllPipelines(cs.Tekton, ns, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -182,11 +203,11 @@ func listPipelineDetails(cs *cli.Clients, ns string) (*v1alpha1.PipelineList, pi
 
 	for _, p := range ps.Items {
 		// TODO: may be just the pipeline details can be print
-		lastRun, err := pipeline.LastRun(cs.Tekton, p.Name, ns)
+		lastRun, err := pipeline.LastRun(cs.Tekton, p.Name, p.Namespace)
 		if err != nil {
 			continue
 		}
-		lastRuns[p.Name] = *lastRun
+		lastRuns[lastRunKey(p)] = *lastRun
 	}
 
 	return ps, lastRuns, nil
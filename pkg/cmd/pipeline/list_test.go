@@ -288,3 +288,66 @@ func seedPipelines(t *testing.T, clock clockwork.Clock, ps []pipelineDetails, ns
 
 	return test.SeedTestData(t, pipelinetest.Data{Pipelines: pipelines, Namespaces: nsList})
 }
+
+func TestPipelinesList_all_namespaces(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		Pipelines: []*v1alpha1.Pipeline{
+			tb.Pipeline("pipeline", "ns",
+				cb.PipelineCreationTimestamp(clock.Now().Add(-5*time.Minute)),
+			),
+			tb.Pipeline("pipeline", "other",
+				cb.PipelineCreationTimestamp(clock.Now().Add(-10*time.Minute)),
+			),
+		},
+		PipelineRuns: []*v1alpha1.PipelineRun{
+			tb.PipelineRun("pipeline-run-1", "other",
+				cb.PipelineRunCreationTimestamp(clock.Now()),
+				tb.PipelineRunLabel("tekton.dev/pipeline", "pipeline"),
+				tb.PipelineRunSpec("pipeline"),
+				tb.PipelineRunStatus(
+					tb.PipelineRunStatusCondition(apis.Condition{
+						Status: corev1.ConditionTrue,
+						Reason: resources.ReasonSucceeded,
+					}),
+					tb.PipelineRunStartTime(clock.Now()),
+					cb.PipelineRunCompletionTime(clock.Now().Add(10*time.Minute)),
+				),
+			),
+		},
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ns",
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "other",
+				},
+			},
+		},
+	})
+
+	p := &test.Params{Tekton: cs.Pipeline, Clock: clock, Kube: cs.Kube}
+	pipeline := Command(p)
+
+	clock.Advance(15 * time.Minute)
+	got, err := test.ExecuteCommand(pipeline, "list", "-A", "--sort-by", "creation-time")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	expected := []string{
+		"NAMESPACE   NAME       AGE              LAST RUN         STARTED          DURATION     STATUS",
+		"ns          pipeline   20 minutes ago   ---              ---              ---          ---",
+		"other       pipeline   25 minutes ago   pipeline-run-1   15 minutes ago   10 minutes   Succeeded",
+		"",
+	}
+
+	text := strings.Join(expected, "\n")
+	if d := cmp.Diff(text, got); d != "" {
+		t.Errorf("Unexpected output mismatch: \n%s\n", d)
+	}
+}
@@ -41,6 +41,8 @@ func Command(p cli.Params) *cobra.Command {
 		startCommand(p),
 		deleteCommand(p),
 		createCommand(p),
+		graphCommand(p),
+		statsCommand(p),
 	)
 	return cmd
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/AlecAivazis/survey/v2/terminal"
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
 	"github.com/tektoncd/cli/pkg/cmd/pipelineresource"
 	"github.com/tektoncd/cli/pkg/cmd/pipelinerun"
 	"github.com/tektoncd/cli/pkg/flags"
@@ -94,6 +95,12 @@ func NameArg(args []string, p cli.Params) error {
 	return nil
 }
 
+func init() {
+	completion.Define("pipeline-params", completion.KeyValue(completePipelineParams, nil))
+	completion.Define("pipeline-resources", completion.KeyValue(completePipelineResources, completePipelineResourceRefs))
+	completion.Define("pipeline-task-serviceaccounts", completion.KeyValue(completePipelineTasks, completion.ServiceAccounts))
+}
+
 func startCommand(p cli.Params) *cobra.Command {
 	opt := startOptions{
 		cliparams: p,
@@ -143,13 +150,15 @@ like cat,foo,bar
 	c.Flags().StringSliceVarP(&opt.Resources, "resource", "r", []string{}, "pass the resource name and ref as name=ref")
 	c.Flags().StringArrayVarP(&opt.Params, "param", "p", []string{}, "pass the param as key=value or key=value1,value2")
 	c.Flags().StringVarP(&opt.ServiceAccountName, "serviceaccount", "s", "", "pass the serviceaccount name")
-	flags.AddShellCompletion(c.Flags().Lookup("serviceaccount"), "__kubectl_get_serviceaccount")
 	c.Flags().StringSliceVar(&opt.ServiceAccounts, "task-serviceaccount", []string{}, "pass the service account corresponding to the task")
-	flags.AddShellCompletion(c.Flags().Lookup("task-serviceaccount"), "__kubectl_get_serviceaccount")
 	c.Flags().BoolVarP(&opt.Last, "last", "L", false, "re-run the pipeline using last pipelinerun values")
 	c.Flags().StringSliceVarP(&opt.Labels, "labels", "l", []string{}, "pass labels as label=value.")
 
-	_ = c.MarkZshCompPositionalArgumentCustom(1, "__tkn_get_pipeline")
+	completion.RegisterArgs(c, 1, "pipeline")
+	completion.RegisterFlag(c, "param", "pipeline-params")
+	completion.RegisterFlag(c, "resource", "pipeline-resources")
+	completion.RegisterFlag(c, "serviceaccount", "serviceaccount")
+	completion.RegisterFlag(c, "task-serviceaccount", "pipeline-task-serviceaccounts")
 
 	return c
 }
@@ -567,3 +576,70 @@ func (opt *startOptions) createPipelineResource(resName string, resType v1alpha1
 	fmt.Fprintf(opt.stream.Out, "New %s resource \"%s\" has been created\n", newRes.Spec.Type, newRes.Name)
 	return newRes, nil
 }
+
+// completedPipeline returns the pipeline being started on the command line
+// being completed, or nil if it isn't there yet
+func completedPipeline(p cli.Params, args []string) (*v1alpha1.Pipeline, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+
+	cs, err := p.Clients()
+	if err != nil {
+		return nil, err
+	}
+	return cs.Tekton.TektonV1alpha1().Pipelines(p.Namespace()).Get(args[0], metav1.GetOptions{})
+}
+
+func completePipelineParams(p cli.Params, args []string) ([]string, error) {
+	pipeline, err := completedPipeline(p, args)
+	if pipeline == nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, param := range pipeline.Spec.Params {
+		names = append(names, param.Name)
+	}
+	return names, nil
+}
+
+func completePipelineResources(p cli.Params, args []string) ([]string, error) {
+	pipeline, err := completedPipeline(p, args)
+	if pipeline == nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, res := range pipeline.Spec.Resources {
+		names = append(names, res.Name)
+	}
+	return names, nil
+}
+
+func completePipelineResourceRefs(p cli.Params, args []string, key string) ([]string, error) {
+	pipeline, err := completedPipeline(p, args)
+	if pipeline == nil {
+		return nil, err
+	}
+
+	for _, res := range pipeline.Spec.Resources {
+		if res.Name == key {
+			return completion.Resources(p, res.Type)
+		}
+	}
+	return nil, nil
+}
+
+func completePipelineTasks(p cli.Params, args []string) ([]string, error) {
+	pipeline, err := completedPipeline(p, args)
+	if pipeline == nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, task := range pipeline.Spec.Tasks {
+		names = append(names, task.Name)
+	}
+	return names, nil
+}
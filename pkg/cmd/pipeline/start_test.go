@@ -25,7 +25,9 @@ import (
 	"github.com/Netflix/go-expect"
 	"github.com/google/go-cmp/cmp"
 	"github.com/jonboulle/clockwork"
+	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
 	"github.com/tektoncd/cli/pkg/helper/pipeline"
 	"github.com/tektoncd/cli/pkg/test"
 	cb "github.com/tektoncd/cli/pkg/test/builder"
@@ -2572,3 +2574,91 @@ func startOpts(ns string, cs pipelinetest.Clients, last bool, svc string, svcs [
 
 	return &startOp
 }
+
+func Test_start_pipeline_completion(t *testing.T) {
+	ps := []*v1alpha1.Pipeline{
+		tb.Pipeline("test-pipeline", "ns",
+			tb.PipelineSpec(
+				tb.PipelineDeclaredResource("git-repo", "git"),
+				tb.PipelineDeclaredResource("image", "image"),
+				tb.PipelineParamSpec("pipeline-param", v1alpha1.ParamTypeString),
+				tb.PipelineParamSpec("rev-param", v1alpha1.ParamTypeString),
+				tb.PipelineTask("unit-test", "unit-test-task"),
+				tb.PipelineTask("build", "build-task"),
+			),
+		),
+	}
+	prs := []*v1alpha1.PipelineResource{
+		tb.PipelineResource("scaffold-git", "ns", tb.PipelineResourceSpec(v1alpha1.PipelineResourceTypeGit)),
+		tb.PipelineResource("cli-git", "ns", tb.PipelineResourceSpec(v1alpha1.PipelineResourceTypeGit)),
+		tb.PipelineResource("cli-image", "ns", tb.PipelineResourceSpec(v1alpha1.PipelineResourceTypeImage)),
+	}
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{Pipelines: ps, PipelineResources: prs, Namespaces: ns})
+	if _, err := cs.Kube.CoreV1().ServiceAccounts("ns").Create(&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "builder"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "pipelines",
+			args: []string{"start", "-n", "ns", ""},
+			want: "test-pipeline\n:4\n",
+		},
+		{
+			name: "params",
+			args: []string{"start", "test-pipeline", "-n", "ns", "-p", ""},
+			want: "pipeline-param=\nrev-param=\n:6\n",
+		},
+		{
+			name: "resources",
+			args: []string{"start", "test-pipeline", "-n", "ns", "-r", ""},
+			want: "git-repo=\nimage=\n:6\n",
+		},
+		{
+			name: "resources of the declared type",
+			args: []string{"start", "test-pipeline", "-n", "ns", "-r", "git-repo="},
+			want: "git-repo=cli-git\ngit-repo=scaffold-git\n:4\n",
+		},
+		{
+			name: "task service accounts",
+			args: []string{"start", "test-pipeline", "-n", "ns", "--task-serviceaccount", "b"},
+			want: "build=\n:6\n",
+		},
+		{
+			name: "service accounts of a task",
+			args: []string{"start", "test-pipeline", "-n", "ns", "--task-serviceaccount", "build="},
+			want: "build=builder\n:4\n",
+		},
+		{
+			name: "no pipeline yet",
+			args: []string{"start", "-n", "ns", "-p", ""},
+			want: ":6\n",
+		},
+	}
+
+	for _, td := range tests {
+		t.Run(td.name, func(t *testing.T) {
+			p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+			root := &cobra.Command{Use: "tkn"}
+			root.AddCommand(Command(p), completion.CompleteCommand(p))
+
+			got, err := test.ExecuteCommand(root, append([]string{completion.CommandName, "pipeline"}, td.args...)...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			test.AssertOutput(t, td.want, got)
+		})
+	}
+}
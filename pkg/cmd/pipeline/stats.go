@@ -0,0 +1,350 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"text/tabwriter"
+	"text/template"
+	"time"
+
+	"github.com/hako/durafmt"
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
+	prhsort "github.com/tektoncd/cli/pkg/helper/pipelinerun/sort"
+	validate "github.com/tektoncd/cli/pkg/helper/validate"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	statsOutputTable = "table"
+	statsOutputJSON  = "json"
+
+	// maxFailureReasons is how many of the most frequent failure reasons
+	// are shown
+	maxFailureReasons = 5
+)
+
+const statsTemplate = `Pipeline:	{{ .Pipeline }}
+Runs:	{{ .Runs }} ({{ .Succeeded }} succeeded, {{ .Failed }} failed, {{ .Running }} running)
+Success Rate:	{{ if .SuccessRate }}{{ printf "%.1f" (deref .SuccessRate) }}%{{ else }}---{{ end }}
+Duration:	{{ if .Duration }}p50 {{ formatSeconds .Duration.P50 }}, p90 {{ formatSeconds .Duration.P90 }}, max {{ formatSeconds .Duration.Max }}{{ else }}---{{ end }}
+
+Tasks
+{{- $tl := len .Tasks }}{{ if eq $tl 0 }}
+No tasks
+{{- else }}
+NAME	RUNS	AVERAGE DURATION	FAILURES
+{{- range $t := .Tasks }}
+{{ $t.Name }}	{{ $t.Runs }}	{{ if $t.Average }}{{ formatSeconds (deref $t.Average) }}{{ else }}---{{ end }}	{{ $t.Failures }}
+{{- end }}
+{{- end }}
+
+Failure Reasons
+{{- $rl := len .FailureReasons }}{{ if eq $rl 0 }}
+No failures
+{{- else }}
+REASON	COUNT
+{{- range $r := .FailureReasons }}
+{{ $r.Reason }}	{{ $r.Count }}
+{{- end }}
+{{- end }}
+`
+
+type statsOptions struct {
+	Last   int
+	Output string
+}
+
+// pipelineStats aggregates the last runs of a pipeline, the durations are
+// in seconds and only count the completed runs
+type pipelineStats struct {
+	Pipeline       string         `json:"pipeline"`
+	Runs           int            `json:"runs"`
+	Succeeded      int            `json:"succeeded"`
+	Failed         int            `json:"failed"`
+	Running        int            `json:"running"`
+	SuccessRate    *float64       `json:"successRate,omitempty"`
+	Duration       *durationStats `json:"duration,omitempty"`
+	Tasks          []taskStats    `json:"tasks"`
+	FailureReasons []reasonCount  `json:"failureReasons"`
+}
+
+type durationStats struct {
+	P50 float64 `json:"p50"`
+	P90 float64 `json:"p90"`
+	Max float64 `json:"max"`
+}
+
+type taskStats struct {
+	Name     string   `json:"name"`
+	Runs     int      `json:"runs"`
+	Average  *float64 `json:"average,omitempty"`
+	Failures int      `json:"failures"`
+}
+
+type reasonCount struct {
+	Reason string `json:"reason"`
+	Count  int    `json:"count"`
+}
+
+func statsCommand(p cli.Params) *cobra.Command {
+	opts := &statsOptions{}
+	eg := `
+# Show the statistics of the last 50 runs of the Pipeline 'foo' in namespace 'bar'
+tkn pipeline stats foo -n bar
+
+# Show the statistics of the last 10 runs of the Pipeline 'foo' as JSON
+tkn pipeline stats foo -n bar --last 10 -o json
+`
+
+	c := &cobra.Command{
+		Use:          "stats",
+		Short:        "Show the statistics of the last runs of a pipeline",
+		Example:      eg,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Last <= 0 {
+				return fmt.Errorf("last was %d but must be a positive number", opts.Last)
+			}
+			if opts.Output != statsOutputTable && opts.Output != statsOutputJSON {
+				return fmt.Errorf("invalid output format %q, must be one of: table|json", opts.Output)
+			}
+
+			if err := validate.NamespaceExists(p); err != nil {
+				return err
+			}
+
+			return printPipelineStats(cmd.OutOrStdout(), p, args[0], opts)
+		},
+	}
+
+	c.Flags().IntVarP(&opts.Last, "last", "", 50, "number of the last pipelineruns to aggregate")
+	c.Flags().StringVarP(&opts.Output, "output", "o", statsOutputTable, "Output format. One of: table|json")
+
+	completion.RegisterArgs(c, 1, "pipeline")
+	return c
+}
+
+func printPipelineStats(out io.Writer, p cli.Params, pname string, opts *statsOptions) error {
+	cs, err := p.Clients()
+	if err != nil {
+		return err
+	}
+
+	pipeline, err := cs.Tekton.TektonV1alpha1().Pipelines(p.Namespace()).Get(pname, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	lOpts := metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("tekton.dev/pipeline=%s", pname),
+	}
+	prs, err := cs.Tekton.TektonV1alpha1().PipelineRuns(p.Namespace()).List(lOpts)
+	if err != nil {
+		return fmt.Errorf("failed to list pipelineruns from %s namespace: %s", p.Namespace(), err)
+	}
+
+	runs := prhsort.SortPipelineRunsByStartTime(prs.Items)
+	if len(runs) > opts.Last {
+		runs = runs[:opts.Last]
+	}
+
+	stats := aggregateStats(pipeline, runs)
+
+	if opts.Output == statsOutputJSON {
+		b, err := json.MarshalIndent(stats, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(b))
+		return err
+	}
+
+	funcMap := template.FuncMap{
+		"deref":         func(f *float64) float64 { return *f },
+		"formatSeconds": formatSeconds,
+	}
+
+	t, err := template.New("Pipeline Stats").Funcs(funcMap).Parse(statsTemplate)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	if err := t.Execute(w, stats); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// aggregateStats computes the statistics of the runs of the pipeline, the
+// tasks are in the order of the pipeline followed by the ones it no longer
+// has
+func aggregateStats(pipeline *v1alpha1.Pipeline, runs []v1alpha1.PipelineRun) *pipelineStats {
+	stats := &pipelineStats{
+		Pipeline:       pipeline.Name,
+		Runs:           len(runs),
+		Tasks:          []taskStats{},
+		FailureReasons: []reasonCount{},
+	}
+
+	durations := []float64{}
+	reasons := map[string]int{}
+	tasks := map[string]*taskStats{}
+	taskDurations := map[string][]float64{}
+
+	for _, pr := range runs {
+		c := pr.Status.GetCondition(apis.ConditionSucceeded)
+		switch {
+		case c == nil || c.Status == corev1.ConditionUnknown:
+			stats.Running++
+		case c.Status == corev1.ConditionTrue:
+			stats.Succeeded++
+		default:
+			stats.Failed++
+			reason := c.Reason
+			if reason == "" {
+				reason = "Failed"
+			}
+			reasons[reason]++
+		}
+		if d, ok := seconds(pr.Status.StartTime, pr.Status.CompletionTime); ok {
+			durations = append(durations, d)
+		}
+
+		for _, trs := range pr.Status.TaskRuns {
+			name := trs.PipelineTaskName
+			if tasks[name] == nil {
+				tasks[name] = &taskStats{Name: name}
+			}
+			tasks[name].Runs++
+			if trs.Status == nil {
+				continue
+			}
+
+			c := trs.Status.GetCondition(apis.ConditionSucceeded)
+			if c == nil || c.Status == corev1.ConditionUnknown {
+				continue
+			}
+			if c.Status == corev1.ConditionFalse {
+				tasks[name].Failures++
+			}
+			if d, ok := seconds(trs.Status.StartTime, trs.Status.CompletionTime); ok {
+				taskDurations[name] = append(taskDurations[name], d)
+			}
+		}
+	}
+
+	if completed := stats.Succeeded + stats.Failed; completed > 0 {
+		rate := 100 * float64(stats.Succeeded) / float64(completed)
+		stats.SuccessRate = &rate
+	}
+
+	if len(durations) > 0 {
+		sort.Float64s(durations)
+		stats.Duration = &durationStats{
+			P50: percentile(durations, 50),
+			P90: percentile(durations, 90),
+			Max: durations[len(durations)-1],
+		}
+	}
+
+	names := []string{}
+	for _, t := range pipeline.Spec.Tasks {
+		names = append(names, t.Name)
+	}
+	removed := []string{}
+	for name := range tasks {
+		if !contains(names, name) {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(removed)
+
+	for _, name := range append(names, removed...) {
+		t, ok := tasks[name]
+		if !ok {
+			continue
+		}
+		if d := taskDurations[name]; len(d) > 0 {
+			avg := 0.0
+			for _, s := range d {
+				avg += s
+			}
+			avg /= float64(len(d))
+			t.Average = &avg
+		}
+		stats.Tasks = append(stats.Tasks, *t)
+	}
+
+	for reason, count := range reasons {
+		stats.FailureReasons = append(stats.FailureReasons, reasonCount{Reason: reason, Count: count})
+	}
+	sort.Slice(stats.FailureReasons, func(i, j int) bool {
+		ri, rj := stats.FailureReasons[i], stats.FailureReasons[j]
+		if ri.Count != rj.Count {
+			return ri.Count > rj.Count
+		}
+		return ri.Reason < rj.Reason
+	})
+	if len(stats.FailureReasons) > maxFailureReasons {
+		stats.FailureReasons = stats.FailureReasons[:maxFailureReasons]
+	}
+
+	return stats
+}
+
+// seconds returns the duration between the times, if both are set
+func seconds(start, end *metav1.Time) (float64, bool) {
+	if start.IsZero() || end.IsZero() {
+		return 0, false
+	}
+	return end.Sub(start.Time).Seconds(), true
+}
+
+// percentile returns the nearest-rank percentile of sorted values
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func formatSeconds(s float64) string {
+	return durafmt.ParseShort(time.Duration(s * float64(time.Second))).String()
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,171 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/cli/pkg/test"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	tb "github.com/tektoncd/pipeline/test/builder"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestPipelineStats(t *testing.T) {
+	start := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	taskRun := func(task string, status corev1.ConditionStatus, started time.Time, d time.Duration) *v1alpha1.PipelineRunTaskRunStatus {
+		ops := []tb.TaskRunStatusOp{
+			tb.StatusCondition(apis.Condition{Type: apis.ConditionSucceeded, Status: status}),
+			tb.TaskRunStartTime(started),
+		}
+		if status != corev1.ConditionUnknown {
+			ops = append(ops, tb.TaskRunCompletionTime(started.Add(d)))
+		}
+		tr := tb.TaskRun("tr", "ns", tb.TaskRunStatus(ops...))
+		return &v1alpha1.PipelineRunTaskRunStatus{PipelineTaskName: task, Status: &tr.Status}
+	}
+
+	pipelineRun := func(name string, status corev1.ConditionStatus, reason string, started time.Time, d time.Duration, trs ...*v1alpha1.PipelineRunTaskRunStatus) *v1alpha1.PipelineRun {
+		ops := []tb.PipelineRunStatusOp{
+			tb.PipelineRunStatusCondition(apis.Condition{Type: apis.ConditionSucceeded, Status: status, Reason: reason}),
+			tb.PipelineRunStartTime(started),
+		}
+		if status != corev1.ConditionUnknown {
+			ops = append(ops, tb.PipelineRunCompletionTime(started.Add(d)))
+		}
+		for _, tr := range trs {
+			ops = append(ops, tb.PipelineRunTaskRunsStatus(name+"-"+tr.PipelineTaskName, tr))
+		}
+		return tb.PipelineRun(name, "ns",
+			tb.PipelineRunLabel("tekton.dev/pipeline", "pipeline"),
+			tb.PipelineRunSpec("pipeline"),
+			tb.PipelineRunStatus(ops...),
+		)
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		Pipelines: []*v1alpha1.Pipeline{
+			tb.Pipeline("pipeline", "ns",
+				tb.PipelineSpec(
+					tb.PipelineTask("fetch", "git-clone"),
+					tb.PipelineTask("build", "buildah"),
+				),
+			),
+		},
+		PipelineRuns: []*v1alpha1.PipelineRun{
+			pipelineRun("pr-1", corev1.ConditionTrue, "Succeeded", start, 10*time.Minute,
+				taskRun("fetch", corev1.ConditionTrue, start, time.Minute),
+				taskRun("build", corev1.ConditionTrue, start, 8*time.Minute),
+			),
+			pipelineRun("pr-2", corev1.ConditionFalse, "Failed", start.Add(time.Hour), 5*time.Minute,
+				taskRun("fetch", corev1.ConditionTrue, start.Add(time.Hour), time.Minute),
+				taskRun("build", corev1.ConditionFalse, start.Add(time.Hour), 3*time.Minute),
+			),
+			pipelineRun("pr-3", corev1.ConditionFalse, "PipelineRunTimeout", start.Add(2*time.Hour), 20*time.Minute,
+				taskRun("fetch", corev1.ConditionTrue, start.Add(2*time.Hour), 4*time.Minute),
+				taskRun("lint", corev1.ConditionFalse, start.Add(2*time.Hour), 2*time.Minute),
+			),
+			pipelineRun("pr-4", corev1.ConditionUnknown, "Running", start.Add(3*time.Hour), 0,
+				taskRun("fetch", corev1.ConditionUnknown, start.Add(3*time.Hour), 0),
+			),
+		},
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ns",
+				},
+			},
+		},
+	})
+
+	testParams := []struct {
+		name     string
+		command  []string
+		expected string
+	}{
+		{
+			name:    "table",
+			command: []string{"stats", "pipeline", "-n", "ns"},
+			expected: `Pipeline:       pipeline
+Runs:           4 (1 succeeded, 2 failed, 1 running)
+Success Rate:   33.3%
+Duration:       p50 10 minutes, p90 20 minutes, max 20 minutes
+
+Tasks
+NAME    RUNS   AVERAGE DURATION   FAILURES
+fetch   4      2 minutes          0
+build   2      5 minutes          1
+lint    1      2 minutes          1
+
+Failure Reasons
+REASON               COUNT
+Failed               1
+PipelineRunTimeout   1
+`,
+		},
+		{
+			name:    "json of the last run",
+			command: []string{"stats", "pipeline", "-n", "ns", "--last", "1", "-o", "json"},
+			expected: `{
+  "pipeline": "pipeline",
+  "runs": 1,
+  "succeeded": 0,
+  "failed": 0,
+  "running": 1,
+  "tasks": [
+    {
+      "name": "fetch",
+      "runs": 1,
+      "failures": 0
+    }
+  ],
+  "failureReasons": []
+}
+`,
+		},
+		{
+			name:     "invalid last",
+			command:  []string{"stats", "pipeline", "-n", "ns", "--last", "0"},
+			expected: "Error: last was 0 but must be a positive number\n",
+		},
+		{
+			name:     "invalid output",
+			command:  []string{"stats", "pipeline", "-n", "ns", "-o", "yaml"},
+			expected: "Error: invalid output format \"yaml\", must be one of: table|json\n",
+		},
+		{
+			name:     "pipeline not found",
+			command:  []string{"stats", "missing", "-n", "ns"},
+			expected: "Error: pipelines.tekton.dev \"missing\" not found\n",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+			pipeline := Command(p)
+			got, _ := test.ExecuteCommand(pipeline, tp.command...)
+			if d := cmp.Diff(tp.expected, got); d != "" {
+				t.Errorf("Unexpected output mismatch: \n%s\n", d)
+			}
+		})
+	}
+}
@@ -19,7 +19,9 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
 	"github.com/tektoncd/cli/pkg/helper/options"
+	phelper "github.com/tektoncd/cli/pkg/helper/pipeline"
 	validateinput "github.com/tektoncd/cli/pkg/helper/validate"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	cliopts "k8s.io/cli-runtime/pkg/genericclioptions"
@@ -33,12 +35,21 @@ func deleteCommand(p cli.Params) *cobra.Command {
 tkn resource delete foo -n bar
 
 tkn res rm foo -n bar
+
+# Delete the PipelineResources 'foo' and 'baz' in namespace 'bar'
+tkn res rm foo baz -n bar
 `
 
 	c := &cobra.Command{
-		Use:          "delete",
-		Aliases:      []string{"rm"},
-		Short:        "Delete a pipeline resource in a namespace",
+		Use:     "delete",
+		Aliases: []string{"rm"},
+		Short:   "Delete pipeline resources in a namespace",
+		Long: `Delete pipeline resources in a namespace
+
+Pipelines and tasks only declare the types of their resources, so the
+pipeline resources still in use are the ones bound by any of the
+pipelineruns or taskruns of the namespace. They are only deleted with
+--force.`,
 		Example:      eg,
 		Args:         cobra.MinimumNArgs(1),
 		SilenceUsage: true,
@@ -52,33 +63,55 @@ tkn res rm foo -n bar
 				Err: cmd.OutOrStderr(),
 			}
 
+			if err := opts.ValidateCascade(); err != nil {
+				return err
+			}
+
 			if err := validateinput.NamespaceExists(p); err != nil {
 				return err
 			}
 
-			if err := opts.CheckOptions(s, args[0]); err != nil {
+			cs, err := p.Clients()
+			if err != nil {
+				return fmt.Errorf("failed to create tekton client")
+			}
+
+			if opts.Dependents, err = phelper.ResourceDependents(cs.Tekton, p.Namespace(), args); err != nil {
+				return fmt.Errorf("failed to look up the pipelines using the pipelineresources: %s", err)
+			}
+
+			if err := opts.CheckOptions(s, args...); err != nil {
 				return err
 			}
 
-			return deleteResource(s, p, args[0])
+			return opts.DeleteEach(s, args, func(name string) error {
+				return deleteResource(opts, s, p, name)
+			})
 		},
 	}
 	f.AddFlags(c)
-	c.Flags().BoolVarP(&opts.ForceDelete, "force", "f", false, "Whether to force deletion (default: false)")
+	c.Flags().BoolVarP(&opts.ForceDelete, "force", "f", false, "Whether to force deletion, even of pipelineresources bound by pipelineruns or taskruns (default: false)")
+	opts.AddCascadeFlags(c)
 
-	_ = c.MarkZshCompPositionalArgumentCustom(1, "__tkn_get_pipelineresource")
+	completion.RegisterArgs(c, 0, "pipelineresource")
 	return c
 }
 
-func deleteResource(s *cli.Stream, p cli.Params, preName string) error {
+func deleteResource(opts *options.DeleteOptions, s *cli.Stream, p cli.Params, preName string) error {
 	cs, err := p.Clients()
 	if err != nil {
 		return fmt.Errorf("failed to create tekton client")
 	}
 
-	if err := cs.Tekton.TektonV1alpha1().PipelineResources(p.Namespace()).Delete(preName, &metav1.DeleteOptions{}); err != nil {
+	if err := cs.Tekton.TektonV1alpha1().PipelineResources(p.Namespace()).Delete(preName, opts.KubeDeleteOptions()); err != nil {
 		return fmt.Errorf("failed to delete pipelineresource %q: %s", preName, err)
 	}
+	if err := opts.WaitDeleted(func() error {
+		_, err := cs.Tekton.TektonV1alpha1().PipelineResources(p.Namespace()).Get(preName, metav1.GetOptions{})
+		return err
+	}); err != nil {
+		return fmt.Errorf("failed to wait for pipelineresource %q to be deleted: %s", preName, err)
+	}
 
 	fmt.Fprintf(s.Out, "PipelineResource deleted: %s\n", preName)
 	return nil
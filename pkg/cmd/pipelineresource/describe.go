@@ -16,14 +16,19 @@ package pipelineresource
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"text/tabwriter"
 	"text/template"
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
 	validateinput "github.com/tektoncd/cli/pkg/helper/validate"
+	"github.com/tektoncd/cli/pkg/printer"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	cliopts "k8s.io/cli-runtime/pkg/genericclioptions"
 )
 
@@ -81,16 +86,48 @@ tkn res desc foo -n bar
 				return err
 			}
 
-			return printPipelineResourceDescription(s, p, args[0])
+			output, err := cmd.LocalFlags().GetString("output")
+			if err != nil {
+				fmt.Fprint(os.Stderr, "Error: output option not set properly \n")
+				return err
+			}
+
+			if output != "" {
+				return printPipelineResourceObj(s.Out, p, args[0], f)
+			}
+
+			return printPipelineResourceDescription(s, p, args[0], f)
 		},
 	}
 
-	_ = c.MarkZshCompPositionalArgumentCustom(1, "__tkn_get_pipelineresource")
+	completion.RegisterArgs(c, 1, "pipelineresource")
 	f.AddFlags(c)
 	return c
 }
 
-func printPipelineResourceDescription(s *cli.Stream, p cli.Params, preName string) error {
+func printPipelineResourceObj(w io.Writer, p cli.Params, preName string, f *cliopts.PrintFlags) error {
+	cs, err := p.Clients()
+	if err != nil {
+		return fmt.Errorf("failed to create tekton client")
+	}
+
+	pre, err := cs.Tekton.TektonV1alpha1().PipelineResources(p.Namespace()).Get(preName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to find pipelineresource %q", preName)
+	}
+
+	// NOTE: this is required for -o json|yaml to work properly since
+	// tektoncd go client fails to set these; probably a bug
+	pre.GetObjectKind().SetGroupVersionKind(
+		schema.GroupVersionKind{
+			Version: "tekton.dev/v1alpha1",
+			Kind:    "PipelineResource",
+		})
+
+	return printer.PrintObject(w, pre, f)
+}
+
+func printPipelineResourceDescription(s *cli.Stream, p cli.Params, preName string, f *cliopts.PrintFlags) error {
 	cs, err := p.Clients()
 	if err != nil {
 		return fmt.Errorf("failed to create tekton client")
@@ -109,8 +146,17 @@ func printPipelineResourceDescription(s *cli.Stream, p cli.Params, preName strin
 		Params:           p,
 	}
 
+	tmpl, err := printer.DescribeTemplate(f, templ)
+	if err != nil {
+		return err
+	}
+
+	t, err := template.New("Describe PipelineResource").Parse(tmpl)
+	if err != nil {
+		return err
+	}
+
 	w := tabwriter.NewWriter(s.Out, 0, 5, 3, ' ', tabwriter.TabIndent)
-	t := template.Must(template.New("Describe PipelineResource").Parse(templ))
 
 	err = t.Execute(w, data)
 	if err != nil {
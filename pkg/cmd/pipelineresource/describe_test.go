@@ -149,3 +149,53 @@ func TestPipelineResourceDescribe_WithSecretParams(t *testing.T) {
 
 	test.AssertOutput(t, strings.Join(expected, "\n"), out)
 }
+
+func TestPipelineResourceDescribe_output(t *testing.T) {
+	pres := []*v1alpha1.PipelineResource{
+		tb.PipelineResource("test-1", "test-ns-1",
+			tb.PipelineResourceSpec("image",
+				tb.PipelineResourceSpecParam("URL", "quay.io/tekton/controller"),
+			),
+		),
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		PipelineResources: pres,
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-ns-1",
+				},
+			},
+		},
+	})
+
+	testParams := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "jsonpath output",
+			args:     []string{"desc", "test-1", "-n", "test-ns-1", "-o", "jsonpath={.kind}/{.metadata.name}/{.spec.type}"},
+			expected: "PipelineResource/test-1/image",
+		},
+		{
+			name:     "custom text template",
+			args:     []string{"desc", "test-1", "-n", "test-ns-1", "--template", "{{ range .PipelineResource.Spec.Params }}{{ .Name }}={{ .Value }}{{ end }}\n"},
+			expected: "URL=quay.io/tekton/controller\n",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+			res := Command(p)
+			got, err := test.ExecuteCommand(res, tp.args...)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			test.AssertOutput(t, tp.expected, got)
+		})
+	}
+}
@@ -23,7 +23,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
-	validinput "github.com/tektoncd/cli/pkg/helper/validate"
+	"github.com/tektoncd/cli/pkg/helper/options"
 	"github.com/tektoncd/cli/pkg/printer"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
 	"github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
@@ -38,6 +38,7 @@ const (
 
 type ListOptions struct {
 	Type string
+	options.ListOptions
 }
 
 func listCommand(p cli.Params) *cobra.Command {
@@ -61,7 +62,11 @@ tkn pre list -n foo
 		Args: cobra.MinimumNArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
 
-			if err := validinput.NamespaceExists(p); err != nil {
+			if err := opts.ValidateNamespace(p); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
 				return err
 			}
 
@@ -81,7 +86,7 @@ tkn pre list -n foo
 				return fmt.Errorf("failed to list pipelineresources. Invalid resource type %s", opts.Type)
 			}
 
-			pres, err := list(cs.Tekton, p.Namespace(), opts.Type)
+			pres, err := list(cs.Tekton, opts.Namespace(p), opts)
 			stream := &cli.Stream{
 				Out: cmd.OutOrStdout(),
 				Err: cmd.OutOrStderr(),
@@ -101,7 +106,7 @@ tkn pre list -n foo
 				return printer.PrintObject(stream.Out, pres, f)
 			}
 
-			err = printFormatted(stream, pres)
+			err = printFormatted(stream, pres, opts.AllNamespaces)
 			if err != nil {
 				fmt.Fprint(os.Stderr, "Failed to print Pipelineresources \n")
 				return err
@@ -110,26 +115,33 @@ tkn pre list -n foo
 		},
 	}
 
-	f.AddFlags(cmd)
+	printer.AddListFlags(cmd, f)
+	opts.AddFlags(cmd)
+	opts.AddAllNamespacesFlag(cmd)
 	cmd.Flags().StringVarP(&opts.Type, "type", "t", "", "Pipeline resource type")
 
 	return cmd
 }
 
-func list(client versioned.Interface, namespace string, resourceType string) (*v1alpha1.PipelineResourceList, error) {
+func list(client versioned.Interface, namespace string, opts *ListOptions) (*v1alpha1.PipelineResourceList, error) {
 
 	prec := client.TektonV1alpha1().PipelineResources(namespace)
-	pres, err := prec.List(v1.ListOptions{})
+	pres, err := prec.List(v1.ListOptions{
+		LabelSelector: opts.LabelSelector(),
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if resourceType != "" {
-		pres.Items = filterByType(pres.Items, resourceType)
+	if opts.Type != "" {
+		pres.Items = filterByType(pres.Items, opts.Type)
 	}
 
 	if len(pres.Items) > 0 {
 		pres.Items = sortResourcesByTypeAndName(pres.Items)
+		opts.SortObjects(pres.Items, func(i int) v1.Object {
+			return &pres.Items[i]
+		})
 	}
 
 	// NOTE: this is required for -o json|yaml to work properly since
@@ -143,15 +155,21 @@ func list(client versioned.Interface, namespace string, resourceType string) (*v
 	return pres, nil
 }
 
-func printFormatted(s *cli.Stream, pres *v1alpha1.PipelineResourceList) error {
+func printFormatted(s *cli.Stream, pres *v1alpha1.PipelineResourceList, allNamespaces bool) error {
 	if len(pres.Items) == 0 {
 		fmt.Fprintln(s.Err, msgNoPREsFound)
 		return nil
 	}
 
 	w := tabwriter.NewWriter(s.Out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	if allNamespaces {
+		fmt.Fprint(w, "NAMESPACE\t")
+	}
 	fmt.Fprintln(w, "NAME\tTYPE\tDETAILS")
 	for _, pre := range pres.Items {
+		if allNamespaces {
+			fmt.Fprintf(w, "%s\t", pre.Namespace)
+		}
 		fmt.Fprintf(w, "%s\t%s\t%s\n",
 			pre.Name,
 			pre.Spec.Type,
@@ -16,56 +16,150 @@ package pipelinerun
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
 	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/cli/pkg/helper/options"
+	prhsort "github.com/tektoncd/cli/pkg/helper/pipelinerun/sort"
 	validate "github.com/tektoncd/cli/pkg/helper/validate"
+	"github.com/tektoncd/cli/pkg/helper/watch"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	informers "github.com/tektoncd/pipeline/pkg/client/informers/externalversions"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
 )
 
 const (
 	succeeded   = "Succeeded"
 	failed      = "Failed"
 	prCancelled = "Failed(PipelineRunCancelled)"
+	trCancelled = "Failed(TaskRunCancelled)"
+
+	// cancelWaitTimeout is how long --wait waits for a pipelinerun to be
+	// stopped by default
+	cancelWaitTimeout = 5 * time.Minute
 )
 
 func cancelCommand(p cli.Params) *cobra.Command {
+	opts := &options.CancelOptions{Resource: "pipelinerun", OwnerKind: "pipeline", OwnerLabel: "tekton.dev/pipeline"}
+	waitCancelled := false
+	waitTimeout := cancelWaitTimeout
 	eg := `
   # cancel the PipelineRun named "foo" from the namespace "bar"
     tkn pipelinerun cancel foo -n bar
+
+  # cancel the PipelineRun named "foo" and wait for its tasks to be stopped
+    tkn pipelinerun cancel foo -n bar --wait
+
+  # cancel all the running PipelineRuns of the Pipeline "foo" without confirmation
+    tkn pipelinerun cancel --pipeline foo -n bar -f
+
+  # cancel the running PipelineRuns created by the EventListener "foo"
+    tkn pipelinerun cancel -l triggers.tekton.dev/eventlistener=foo -n bar
    `
 
 	c := &cobra.Command{
 		Use:          "cancel pipelinerunName",
-		Short:        "Cancel the PipelineRun",
+		Short:        "Cancel the PipelineRun, or all the running ones matching the flags",
 		Example:      eg,
 		SilenceUsage: true,
 		Annotations: map[string]string{
 			"commandType": "main",
 		},
-		Args: cobra.ExactArgs(1),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			pr := args[0]
-
 			s := &cli.Stream{
+				In:  cmd.InOrStdin(),
 				Out: cmd.OutOrStdout(),
 				Err: cmd.OutOrStderr(),
 			}
 
+			if err := opts.Validate(args); err != nil {
+				return err
+			}
+			if waitTimeout < 0 {
+				return fmt.Errorf("invalid wait timeout %s, must not be negative", waitTimeout)
+			}
+
 			if err := validate.NamespaceExists(p); err != nil {
 				return err
 			}
 
-			return cancelPipelineRun(p, s, pr)
+			names := args
+			if opts.Bulk() {
+				running, err := runningPipelineRuns(p, opts)
+				if err != nil {
+					return err
+				}
+				if len(running) == 0 {
+					fmt.Fprintln(s.Out, "No running pipelineruns to cancel")
+					return nil
+				}
+
+				if err := opts.CheckOptions(s, running); err != nil {
+					return err
+				}
+				names = running
+			}
+
+			cancelled := []string{}
+			err := opts.CancelEach(s, names, func(name string) error {
+				if err := cancelPipelineRun(p, s, name); err != nil {
+					return err
+				}
+				cancelled = append(cancelled, name)
+				return nil
+			})
+			if !waitCancelled {
+				return err
+			}
+
+			for _, name := range cancelled {
+				if err := waitPipelineRunCancelled(p, s, name, waitTimeout); err != nil {
+					return err
+				}
+			}
+			return err
 		},
 	}
+	opts.AddFlags(c)
+	c.Flags().BoolVarP(&waitCancelled, "wait", "", false, "wait for the pipelineruns, their taskruns and pods to be stopped and report the interrupted tasks")
+	c.Flags().DurationVarP(&waitTimeout, "wait-timeout", "", cancelWaitTimeout, "how long to wait for each pipelinerun to be stopped with --wait, 0 to wait forever")
 
-	_ = c.MarkZshCompPositionalArgumentCustom(1, "__tkn_get_pipelinerun")
+	completion.RegisterArgs(c, 1, "pipelinerun")
 	return c
 }
 
+// runningPipelineRuns returns the names of the pipelineruns selected by the
+// options which are neither done nor already being cancelled, newest first
+func runningPipelineRuns(p cli.Params, opts *options.CancelOptions) ([]string, error) {
+	cs, err := p.Clients()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tekton client")
+	}
+
+	prs, err := cs.Tekton.TektonV1alpha1().PipelineRuns(p.Namespace()).List(opts.ListOptions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pipelineruns from %s namespace: %s", p.Namespace(), err)
+	}
+	names := []string{}
+	runs := prhsort.SortPipelineRunsByStartTime(prs.Items)
+	for i := range runs {
+		if pr := &runs[i]; !pr.IsDone() && !pr.IsCancelled() {
+			names = append(names, pr.Name)
+		}
+	}
+	return names, nil
+}
+
 func cancelPipelineRun(p cli.Params, s *cli.Stream, prName string) error {
 	cs, err := p.Clients()
 	if err != nil {
@@ -91,3 +185,131 @@ func cancelPipelineRun(p cli.Params, s *cli.Stream, prName string) error {
 	fmt.Fprintf(s.Out, "Pipelinerun cancelled: %s\n", pr.Name)
 	return nil
 }
+
+// waitPipelineRunCancelled watches the pipelinerun, its taskruns and their
+// pods until the pipelinerun and taskruns are done and the pods terminated,
+// then reports the tasks the cancellation interrupted and the ones already
+// complete
+func waitPipelineRunCancelled(p cli.Params, s *cli.Stream, prName string, timeout time.Duration) error {
+	cs, err := p.Clients()
+	if err != nil {
+		return fmt.Errorf("failed to create tekton client")
+	}
+
+	fmt.Fprintf(s.Out, "Waiting for pipelinerun %s to be cancelled\n", prName)
+
+	ns := p.Namespace()
+	selector := fmt.Sprintf("tekton.dev/pipelineRun=%s", prName)
+	prFactory := informers.NewSharedInformerFactoryWithOptions(cs.Tekton, 0,
+		informers.WithNamespace(ns),
+		informers.WithTweakListOptions(func(o *metav1.ListOptions) {
+			o.FieldSelector = fields.OneTermEqualSelector("metadata.name", prName).String()
+		}))
+	trFactory := informers.NewSharedInformerFactoryWithOptions(cs.Tekton, 0,
+		informers.WithNamespace(ns),
+		informers.WithTweakListOptions(func(o *metav1.ListOptions) {
+			o.LabelSelector = selector
+		}))
+	podFactory := kubeinformers.NewSharedInformerFactoryWithOptions(cs.Kube, 0,
+		kubeinformers.WithNamespace(ns),
+		kubeinformers.WithTweakListOptions(func(o *metav1.ListOptions) {
+			o.LabelSelector = selector
+		}))
+
+	prInformer := prFactory.Tekton().V1alpha1().PipelineRuns().Informer()
+	trInformer := trFactory.Tekton().V1alpha1().TaskRuns().Informer()
+	podInformer := podFactory.Core().V1().Pods().Informer()
+	changes := watch.Changes(prInformer, trInformer, podInformer)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	prFactory.Start(stop)
+	trFactory.Start(stop)
+	podFactory.Start(stop)
+	prFactory.WaitForCacheSync(stop)
+	trFactory.WaitForCacheSync(stop)
+	podFactory.WaitForCacheSync(stop)
+
+	var expired <-chan time.Time
+	if timeout > 0 {
+		expired = time.After(timeout)
+	}
+
+	for {
+		if trs, ok := pipelineRunStopped(ns, prName, prInformer, trInformer, podInformer); ok {
+			reportCancelled(s, trs)
+			return nil
+		}
+
+		select {
+		case <-changes:
+		case <-expired:
+			return fmt.Errorf("failed to wait for pipelinerun %s to be cancelled: not stopped after %s", prName, timeout)
+		}
+	}
+}
+
+// pipelineRunStopped returns the taskruns of the pipelinerun once it and its
+// taskruns are done and the pods of the taskruns are gone or terminated
+func pipelineRunStopped(ns, prName string, prInformer, trInformer, podInformer cache.SharedIndexInformer) ([]v1alpha1.TaskRun, bool) {
+	obj, exists, err := prInformer.GetStore().GetByKey(ns + "/" + prName)
+	if err != nil || !exists {
+		return nil, false
+	}
+	if pr, ok := obj.(*v1alpha1.PipelineRun); !ok || !pr.IsDone() {
+		return nil, false
+	}
+
+	trs := []v1alpha1.TaskRun{}
+	for _, obj := range trInformer.GetStore().List() {
+		tr, ok := obj.(*v1alpha1.TaskRun)
+		if !ok {
+			continue
+		}
+		if !tr.IsDone() || !podStopped(podInformer, ns, tr.Status.PodName) {
+			return nil, false
+		}
+		trs = append(trs, *tr)
+	}
+	return trs, true
+}
+
+// podStopped returns whether the pod of a taskrun is gone or has terminated
+func podStopped(podInformer cache.SharedIndexInformer, ns, name string) bool {
+	if name == "" {
+		return true
+	}
+
+	obj, exists, err := podInformer.GetStore().GetByKey(ns + "/" + name)
+	if err != nil {
+		return false
+	}
+	pod, ok := obj.(*corev1.Pod)
+	if !exists || !ok {
+		return true
+	}
+	return pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+}
+
+// reportCancelled prints the tasks the cancellation interrupted and the ones
+// already complete
+func reportCancelled(s *cli.Stream, trs []v1alpha1.TaskRun) {
+	interrupted, complete := []string{}, []string{}
+	for _, tr := range trs {
+		task := tr.Labels["tekton.dev/pipelineTask"]
+		if formatted.Condition(tr.Status.Conditions) == trCancelled {
+			interrupted = append(interrupted, fmt.Sprintf("  %s (%s)", task, tr.Name))
+		} else {
+			complete = append(complete, fmt.Sprintf("  %s (%s): %s", task, tr.Name, formatted.Condition(tr.Status.Conditions)))
+		}
+	}
+	sort.Strings(interrupted)
+	sort.Strings(complete)
+
+	if len(interrupted) > 0 {
+		fmt.Fprintf(s.Out, "Interrupted tasks:\n%s\n", strings.Join(interrupted, "\n"))
+	}
+	if len(complete) > 0 {
+		fmt.Fprintf(s.Out, "Tasks already complete:\n%s\n", strings.Join(complete, "\n"))
+	}
+}
@@ -17,6 +17,7 @@ package pipelinerun
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/tektoncd/cli/pkg/test"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
@@ -224,3 +225,201 @@ func Test_finished_pipelinerun_failure(t *testing.T) {
 	expected := "Error: failed to cancel pipelinerun " + prName + ": pipelinerun has already finished execution\n"
 	tu.AssertOutput(t, expected, got)
 }
+
+func Test_cancel_pipelineruns_of_pipeline(t *testing.T) {
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	now := time.Now()
+	prs := []*v1alpha1.PipelineRun{
+		tb.PipelineRun("build-1", "ns",
+			tb.PipelineRunLabel("tekton.dev/pipeline", "build"),
+			tb.PipelineRunSpec("build"),
+			tb.PipelineRunStatus(tb.PipelineRunStartTime(now.Add(-2*time.Minute))),
+		),
+		tb.PipelineRun("build-2", "ns",
+			tb.PipelineRunLabel("tekton.dev/pipeline", "build"),
+			tb.PipelineRunSpec("build"),
+			tb.PipelineRunStatus(
+				tb.PipelineRunStatusCondition(failure),
+				tb.PipelineRunStartTime(now.Add(-1*time.Minute)),
+			),
+		),
+		tb.PipelineRun("build-3", "ns",
+			tb.PipelineRunLabel("tekton.dev/pipeline", "build"),
+			tb.PipelineRunSpec("build"),
+			tb.PipelineRunStatus(tb.PipelineRunStartTime(now)),
+		),
+		tb.PipelineRun("deploy-1", "ns",
+			tb.PipelineRunLabel("tekton.dev/pipeline", "deploy"),
+			tb.PipelineRunSpec("deploy"),
+			tb.PipelineRunStatus(tb.PipelineRunStartTime(now)),
+		),
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{PipelineRuns: prs, Namespaces: ns})
+	p := &tu.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+
+	cs.Pipeline.PrependReactor("update", "pipelineruns", func(action k8stest.Action) (bool, runtime.Object, error) {
+		if action.(k8stest.UpdateAction).GetObject().(*v1alpha1.PipelineRun).Name == "build-3" {
+			return true, nil, errors.New("test generated error")
+		}
+		return false, nil, nil
+	})
+
+	pRun := Command(p)
+	got, _ := tu.ExecuteCommand(pRun, "cancel", "--pipeline", "build", "-n", "ns", "-f")
+
+	expected := `The following pipelineruns will be cancelled:
+  build-3
+  build-1
+Error: failed to cancel pipelinerun: build-3, err: test generated error
+Pipelinerun cancelled: build-1
+Error: failed to cancel 1 of 2 pipelineruns
+`
+	tu.AssertOutput(t, expected, got)
+
+	pr, _ := cs.Pipeline.TektonV1alpha1().PipelineRuns("ns").Get("deploy-1", metav1.GetOptions{})
+	if pr.IsCancelled() {
+		t.Errorf("pipelinerun deploy-1 of another pipeline was cancelled")
+	}
+}
+
+func Test_cancel_pipelinerun_wait(t *testing.T) {
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	childTaskRun := func(name, task string, status corev1.ConditionStatus, reason string) *v1alpha1.TaskRun {
+		return tb.TaskRun(name, "ns",
+			tb.TaskRunLabel("tekton.dev/pipelineRun", "run"),
+			tb.TaskRunLabel("tekton.dev/pipelineTask", task),
+			tb.TaskRunSpec(tb.TaskRunTaskRef(task)),
+			tb.TaskRunStatus(
+				tb.StatusCondition(apis.Condition{Type: apis.ConditionSucceeded, Status: status, Reason: reason}),
+				tb.PodName(name+"-pod"),
+			),
+		)
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		PipelineRuns: []*v1alpha1.PipelineRun{
+			tb.PipelineRun("run", "ns",
+				tb.PipelineRunLabel("tekton.dev/pipeline", "pipeline"),
+				tb.PipelineRunSpec("pipeline"),
+				tb.PipelineRunStatus(tb.PipelineRunStatusCondition(apis.Condition{
+					Type:   apis.ConditionSucceeded,
+					Status: corev1.ConditionUnknown,
+					Reason: "Running",
+				})),
+			),
+		},
+		TaskRuns: []*v1alpha1.TaskRun{
+			childTaskRun("run-fetch", "fetch", corev1.ConditionTrue, "Succeeded"),
+			childTaskRun("run-build", "build", corev1.ConditionUnknown, "Running"),
+		},
+		Pods: []*corev1.Pod{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "run-fetch-pod", Namespace: "ns", Labels: map[string]string{"tekton.dev/pipelineRun": "run"}},
+				Status:     corev1.PodStatus{Phase: corev1.PodSucceeded},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "run-build-pod", Namespace: "ns", Labels: map[string]string{"tekton.dev/pipelineRun": "run"}},
+				Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+			},
+		},
+		Namespaces: ns,
+	})
+	p := &tu.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+
+	// stops the pipelinerun like the controller does once it's cancelled:
+	// the running taskrun is cancelled and its pod terminated. The updates
+	// are repeated until the command returns, as the informers may start
+	// watching after the first ones.
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(10 * time.Millisecond):
+			}
+
+			pr, err := cs.Pipeline.TektonV1alpha1().PipelineRuns("ns").Get("run", metav1.GetOptions{})
+			if err != nil || !pr.IsCancelled() {
+				continue
+			}
+			pr.Status.SetCondition(&apis.Condition{Type: apis.ConditionSucceeded, Status: corev1.ConditionFalse, Reason: "PipelineRunCancelled"})
+			_, _ = cs.Pipeline.TektonV1alpha1().PipelineRuns("ns").Update(pr)
+			_, _ = cs.Pipeline.TektonV1alpha1().TaskRuns("ns").Update(childTaskRun("run-build", "build", corev1.ConditionFalse, "TaskRunCancelled"))
+			_, _ = cs.Kube.CoreV1().Pods("ns").Update(&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "run-build-pod", Namespace: "ns", Labels: map[string]string{"tekton.dev/pipelineRun": "run"}},
+				Status:     corev1.PodStatus{Phase: corev1.PodFailed},
+			})
+		}
+	}()
+
+	pRun := Command(p)
+	got, err := tu.ExecuteCommand(pRun, "cancel", "run", "-n", "ns", "--wait", "--wait-timeout", "10s")
+	close(done)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `Pipelinerun cancelled: run
+Waiting for pipelinerun run to be cancelled
+Interrupted tasks:
+  build (run-build)
+Tasks already complete:
+  fetch (run-fetch): Succeeded
+`
+	tu.AssertOutput(t, expected, got)
+}
+
+func Test_cancel_pipelinerun_wait_timeout(t *testing.T) {
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		PipelineRuns: []*v1alpha1.PipelineRun{
+			tb.PipelineRun("run", "ns",
+				tb.PipelineRunSpec("pipeline"),
+				tb.PipelineRunStatus(tb.PipelineRunStatusCondition(apis.Condition{
+					Type:   apis.ConditionSucceeded,
+					Status: corev1.ConditionUnknown,
+					Reason: "Running",
+				})),
+			),
+		},
+		Namespaces: ns,
+	})
+	p := &tu.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+
+	pRun := Command(p)
+	got, err := tu.ExecuteCommand(pRun, "cancel", "run", "-n", "ns", "--wait", "--wait-timeout", "50ms")
+	if err == nil {
+		t.Fatal("error expected when the pipelinerun is not stopped in time")
+	}
+	expected := `Pipelinerun cancelled: run
+Waiting for pipelinerun run to be cancelled
+Error: failed to wait for pipelinerun run to be cancelled: not stopped after 50ms
+`
+	tu.AssertOutput(t, expected, got)
+
+	_, err = tu.ExecuteCommand(Command(p), "cancel", "run", "-n", "ns", "--wait-timeout", "-1s")
+	tu.AssertOutput(t, "invalid wait timeout -1s, must not be negative", err.Error())
+}
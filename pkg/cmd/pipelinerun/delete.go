@@ -19,6 +19,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
 	"github.com/tektoncd/cli/pkg/helper/options"
 	validate "github.com/tektoncd/cli/pkg/helper/validate"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -27,20 +28,32 @@ import (
 
 func deleteCommand(p cli.Params) *cobra.Command {
 	opts := &options.DeleteOptions{Resource: "pipelinerun", ForceDelete: false}
+	runOpts := &options.RunDeleteOptions{}
 	f := cliopts.NewPrintFlags("delete")
 	eg := `
 # Delete a PipelineRun of name 'foo' in namespace 'bar'
 tkn pipelinerun delete foo -n bar
 
 tkn pr rm foo -n bar
+
+# Delete the PipelineRuns 'foo' and 'baz' and wait for their TaskRuns and pods to be gone
+tkn pr rm foo baz -n bar --wait
+
+# Delete the PipelineRuns of namespace 'bar' but the newest 5 of each Pipeline
+tkn pr rm --keep 5 -n bar
+
+# Show the failed PipelineRuns older than a week which would be deleted
+tkn pr rm --status failed --older-than 7d -n bar --dry-run
+
+# Delete all the PipelineRuns of Pipeline 'foo' without being asked
+tkn pr rm --all -l tekton.dev/pipeline=foo -n bar -f
 `
 
 	c := &cobra.Command{
 		Use:          "delete",
 		Aliases:      []string{"rm"},
-		Short:        "Delete a pipelinerun in a namespace",
+		Short:        "Delete pipelineruns in a namespace",
 		Example:      eg,
-		Args:         cobra.MinimumNArgs(1),
 		SilenceUsage: true,
 		Annotations: map[string]string{
 			"commandType": "main",
@@ -52,32 +65,97 @@ tkn pr rm foo -n bar
 				Err: cmd.OutOrStderr(),
 			}
 
+			if err := runOpts.Validate("pipelinerun", args); err != nil {
+				return err
+			}
+
+			if err := opts.ValidateCascade(); err != nil {
+				return err
+			}
+
 			if err := validate.NamespaceExists(p); err != nil {
 				return err
 			}
 
-			if err := opts.CheckOptions(s, args[0]); err != nil {
+			names := args
+			if runOpts.Bulk() {
+				var err error
+				if names, err = selectPipelineRuns(p, runOpts); err != nil {
+					return err
+				}
+				if len(names) == 0 {
+					fmt.Fprintln(s.Out, "No pipelineruns to delete")
+					return nil
+				}
+			}
+
+			if runOpts.Bulk() || opts.DryRun {
+				if err := opts.CheckBulkOptions(s, names); err != nil {
+					return err
+				}
+			} else if err := opts.CheckOptions(s, names...); err != nil {
 				return err
 			}
+			if opts.DryRun {
+				return nil
+			}
 
-			return deletePipelineRun(s, p, args[0])
+			return opts.DeleteEach(s, names, func(name string) error {
+				return deletePipelineRun(opts, s, p, name)
+			})
 		},
 	}
 	f.AddFlags(c)
+	runOpts.AddFlags(c)
 	c.Flags().BoolVarP(&opts.ForceDelete, "force", "f", false, "Whether to force deletion (default: false)")
-	_ = c.MarkZshCompPositionalArgumentCustom(1, "__tkn_get_pipelinerun")
+	opts.AddCascadeFlags(c)
+	c.Flags().BoolVarP(&opts.DryRun, "dry-run", "", false, "only print the pipelineruns which would be deleted")
+	completion.RegisterArgs(c, 0, "pipelinerun")
 	return c
 }
 
-func deletePipelineRun(s *cli.Stream, p cli.Params, prName string) error {
+// selectPipelineRuns returns the names of the pipelineruns of the namespace
+// to delete in bulk
+func selectPipelineRuns(p cli.Params, opts *options.RunDeleteOptions) ([]string, error) {
+	cs, err := p.Clients()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tekton client")
+	}
+
+	prs, err := cs.Tekton.TektonV1alpha1().PipelineRuns(p.Namespace()).List(metav1.ListOptions{
+		LabelSelector: opts.Selector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pipelineruns from namespace %s: %s", p.Namespace(), err)
+	}
+
+	runs := []options.Run{}
+	for _, pr := range prs.Items {
+		runs = append(runs, options.Run{
+			Name:       pr.Name,
+			Group:      pr.Labels["tekton.dev/pipeline"],
+			Conditions: pr.Status.Conditions,
+			Created:    pr.CreationTimestamp.Time,
+		})
+	}
+	return opts.Select(runs, p.Time().Now()), nil
+}
+
+func deletePipelineRun(opts *options.DeleteOptions, s *cli.Stream, p cli.Params, prName string) error {
 	cs, err := p.Clients()
 	if err != nil {
 		return fmt.Errorf("failed to create tekton client")
 	}
 
-	if err := cs.Tekton.TektonV1alpha1().PipelineRuns(p.Namespace()).Delete(prName, &metav1.DeleteOptions{}); err != nil {
+	if err := cs.Tekton.TektonV1alpha1().PipelineRuns(p.Namespace()).Delete(prName, opts.KubeDeleteOptions()); err != nil {
 		return fmt.Errorf("failed to delete pipelinerun %q: %s", prName, err)
 	}
+	if err := opts.WaitDeleted(func() error {
+		_, err := cs.Tekton.TektonV1alpha1().PipelineRuns(p.Namespace()).Get(prName, metav1.GetOptions{})
+		return err
+	}); err != nil {
+		return fmt.Errorf("failed to wait for pipelinerun %q to be deleted: %s", prName, err)
+	}
 
 	fmt.Fprintf(s.Out, "PipelineRun deleted: %s\n", prName)
 	return nil
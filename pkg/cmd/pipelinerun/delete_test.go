@@ -156,3 +156,133 @@ func TestPipelineRunDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestPipelineRunDelete_bulk(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	pipelinerun := func(name, pipeline string, status corev1.ConditionStatus, age time.Duration) *v1alpha1.PipelineRun {
+		return tb.PipelineRun(name, "ns",
+			cb.PipelineRunCreationTimestamp(clock.Now().Add(-age)),
+			tb.PipelineRunLabel("tekton.dev/pipeline", pipeline),
+			tb.PipelineRunSpec(pipeline),
+			tb.PipelineRunStatus(
+				tb.PipelineRunStatusCondition(apis.Condition{
+					Status: status,
+					Reason: resources.ReasonSucceeded,
+				}),
+			),
+		)
+	}
+	prs := []*v1alpha1.PipelineRun{
+		pipelinerun("build-1", "build", corev1.ConditionFalse, 10*24*time.Hour),
+		pipelinerun("build-2", "build", corev1.ConditionTrue, 2*time.Hour),
+		pipelinerun("build-3", "build", corev1.ConditionFalse, time.Hour),
+		pipelinerun("deploy-1", "deploy", corev1.ConditionTrue, 8*24*time.Hour),
+	}
+
+	testParams := []struct {
+		name        string
+		command     []string
+		inputStream io.Reader
+		wantError   bool
+		want        string
+		remaining   []string
+	}{
+		{
+			name:      "Keep the newest of each pipeline",
+			command:   []string{"rm", "-n", "ns", "--keep", "1", "-f"},
+			want:      "The following pipelineruns will be deleted:\n  build-2\n  build-1\nPipelineRun deleted: build-2\nPipelineRun deleted: build-1\n",
+			remaining: []string{"build-3", "deploy-1"},
+		},
+		{
+			name:        "Failed and older than a week, reply yes",
+			command:     []string{"rm", "-n", "ns", "--status", "failed", "--older-than", "7d"},
+			inputStream: strings.NewReader("y"),
+			want:        "The following pipelineruns will be deleted:\n  build-1\nAre you sure you want to delete 1 pipelinerun(s) (y/n): PipelineRun deleted: build-1\n",
+			remaining:   []string{"build-2", "build-3", "deploy-1"},
+		},
+		{
+			name:        "All of a pipeline, reply no",
+			command:     []string{"rm", "-n", "ns", "--all", "-l", "tekton.dev/pipeline=build"},
+			inputStream: strings.NewReader("n"),
+			wantError:   true,
+			want:        "canceled deleting pipelineruns",
+			remaining:   []string{"build-1", "build-2", "build-3", "deploy-1"},
+		},
+		{
+			name:      "Dry run",
+			command:   []string{"rm", "-n", "ns", "--all", "--dry-run"},
+			want:      "The following pipelineruns would be deleted:\n  build-3\n  build-2\n  deploy-1\n  build-1\n",
+			remaining: []string{"build-1", "build-2", "build-3", "deploy-1"},
+		},
+		{
+			name:      "Dry run of a name",
+			command:   []string{"rm", "build-1", "-n", "ns", "--dry-run"},
+			want:      "The following pipelineruns would be deleted:\n  build-1\n",
+			remaining: []string{"build-1", "build-2", "build-3", "deploy-1"},
+		},
+		{
+			name:      "Nothing to delete",
+			command:   []string{"rm", "-n", "ns", "--keep", "5"},
+			want:      "No pipelineruns to delete\n",
+			remaining: []string{"build-1", "build-2", "build-3", "deploy-1"},
+		},
+		{
+			name:      "No name nor selection",
+			command:   []string{"rm", "-n", "ns"},
+			wantError: true,
+			want:      "a pipelinerun name or one of --all, --keep, --older-than, --status or --selector is required",
+			remaining: []string{"build-1", "build-2", "build-3", "deploy-1"},
+		},
+		{
+			name:      "Name and selection",
+			command:   []string{"rm", "build-1", "-n", "ns", "--all"},
+			wantError: true,
+			want:      "--all, --keep, --older-than, --status and --selector can not be used with pipelinerun names",
+			remaining: []string{"build-1", "build-2", "build-3", "deploy-1"},
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			cs, _ := test.SeedTestData(t, pipelinetest.Data{PipelineRuns: prs, Namespaces: ns})
+			p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Clock: clock}
+			pipelinerun := Command(p)
+
+			if tp.inputStream != nil {
+				pipelinerun.SetIn(tp.inputStream)
+			}
+
+			out, err := test.ExecuteCommand(pipelinerun, tp.command...)
+			if tp.wantError {
+				if err == nil {
+					t.Errorf("Error expected here")
+				}
+				test.AssertOutput(t, tp.want, err.Error())
+			} else {
+				if err != nil {
+					t.Errorf("Unexpected Error: %v", err)
+				}
+				test.AssertOutput(t, tp.want, out)
+			}
+
+			list, err := cs.Pipeline.TektonV1alpha1().PipelineRuns("ns").List(metav1.ListOptions{})
+			if err != nil {
+				t.Fatalf("Unexpected Error: %v", err)
+			}
+			remaining := []string{}
+			for _, pr := range list.Items {
+				remaining = append(remaining, pr.Name)
+			}
+			test.AssertOutput(t, tp.remaining, remaining)
+		})
+	}
+}
@@ -16,6 +16,8 @@ package pipelinerun
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"sort"
 
 	"text/tabwriter"
@@ -23,12 +25,17 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
 	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/cli/pkg/helper/pods"
 	validate "github.com/tektoncd/cli/pkg/helper/validate"
+	"github.com/tektoncd/cli/pkg/printer"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	cliopts "k8s.io/cli-runtime/pkg/genericclioptions"
+	"knative.dev/pkg/apis"
 )
 
 const templ = `Name:	{{ .PipelineRun.Name }}
@@ -87,15 +94,48 @@ NAME	TASK NAME	STARTED	DURATION	STATUS
 {{ $taskrun.TaskrunName }}	{{ $taskrun.PipelineTaskName }}	{{ formatAge $taskrun.Status.StartTime $.Params.Time }}	{{ formatDuration $taskrun.Status.StartTime $taskrun.Status.CompletionTime }}	{{ formatCondition $taskrun.Status.Conditions }}
 {{- end }}
 {{- end }}
+{{- if hasPodConditions .Pods }}
+
+Pod Conditions
+TASKRUN	TYPE	STATUS	REASON	MESSAGE
+{{- range $pod := .Pods }}{{ range $c := $pod.Conditions }}
+{{ $pod.TaskrunName }}	{{ $c.Type }}	{{ $c.Status }}	{{ $c.Reason }}	{{ $c.Message }}
+{{- end }}{{ end }}
+{{- end }}
+{{- if hasWaiting .Pods }}
+
+Waiting Containers
+TASKRUN	NAME	REASON	MESSAGE
+{{- range $pod := .Pods }}{{ range $w := $pod.Waiting }}
+{{ $pod.TaskrunName }}	{{ $w.Container }}	{{ $w.Reason }}	{{ $w.Message }}
+{{- end }}{{ end }}
+{{- end }}
+{{- $l := len .Events }}{{ if ne $l 0 }}
+
+Events
+LAST SEEN	TYPE	OBJECT	REASON	MESSAGE
+{{- range $e := .Events }}
+{{ formatAge $e.LastSeen $.Params.Time }}	{{ $e.Type }}	{{ $e.Object }}	{{ $e.Reason }}	{{ $e.Message }}
+{{- end }}
+{{- end }}
 `
 
+type describeOptions struct {
+	Timeline bool
+	Steps    bool
+}
+
 func describeCommand(p cli.Params) *cobra.Command {
+	opts := &describeOptions{}
 	f := cliopts.NewPrintFlags("describe")
 	eg := `
 # Describe a PipelineRun of name 'foo' in namespace 'bar'
 tkn pipelinerun describe foo -n bar
 
 tkn pr desc foo -n bar
+
+# Show when each TaskRun of the PipelineRun 'foo' ran, with its steps
+tkn pr desc foo -n bar --timeline --steps
 `
 
 	c := &cobra.Command{
@@ -118,17 +158,51 @@ tkn pr desc foo -n bar
 				return err
 			}
 
-			return printPipelineRunDescription(s, args[0], p)
+			output, err := cmd.LocalFlags().GetString("output")
+			if err != nil {
+				fmt.Fprint(os.Stderr, "Error: output option not set properly \n")
+				return err
+			}
+
+			if output != "" {
+				return printPipelineRunObj(s.Out, args[0], p, f)
+			}
+
+			return printPipelineRunDescription(s, args[0], p, f, opts)
 		},
 	}
 
-	_ = c.MarkZshCompPositionalArgumentCustom(1, "__tkn_get_pipelinerun")
+	completion.RegisterArgs(c, 1, "pipelinerun")
 	f.AddFlags(c)
+	c.Flags().BoolVarP(&opts.Timeline, "timeline", "", false, "show a timeline of the taskruns highlighting the critical path")
+	c.Flags().BoolVarP(&opts.Steps, "steps", "", false, "show the steps of the taskruns in the timeline")
 
 	return c
 }
 
-func printPipelineRunDescription(s *cli.Stream, prName string, p cli.Params) error {
+func printPipelineRunObj(w io.Writer, prName string, p cli.Params, f *cliopts.PrintFlags) error {
+	cs, err := p.Clients()
+	if err != nil {
+		return fmt.Errorf("failed to create tekton client")
+	}
+
+	pr, err := cs.Tekton.TektonV1alpha1().PipelineRuns(p.Namespace()).Get(prName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to find pipelinerun %q", prName)
+	}
+
+	// NOTE: this is required for -o json|yaml to work properly since
+	// tektoncd go client fails to set these; probably a bug
+	pr.GetObjectKind().SetGroupVersionKind(
+		schema.GroupVersionKind{
+			Version: "tekton.dev/v1alpha1",
+			Kind:    "PipelineRun",
+		})
+
+	return printer.PrintObject(w, pr, f)
+}
+
+func printPipelineRunDescription(s *cli.Stream, prName string, p cli.Params, f *cliopts.PrintFlags, opts *describeOptions) error {
 	cs, err := p.Clients()
 	if err != nil {
 		return fmt.Errorf("failed to create tekton client")
@@ -146,14 +220,23 @@ func printPipelineRunDescription(s *cli.Stream, prName string, p cli.Params) err
 		sort.Sort(trl)
 	}
 
+	events, err := pipelineRunEvents(cs, pr, trl)
+	if err != nil {
+		fmt.Fprintf(s.Err, "Failed to list the events of pipelinerun %s: %v\n", pr.Name, err)
+	}
+
 	var data = struct {
 		PipelineRun *v1alpha1.PipelineRun
 		Params      cli.Params
 		TaskrunList taskrunList
+		Pods        []podDiagnostics
+		Events      []pods.Event
 	}{
 		PipelineRun: pr,
 		Params:      p,
 		TaskrunList: trl,
+		Pods:        taskrunPodDiagnostics(cs, pr.Namespace, trl),
+		Events:      events,
 	}
 
 	funcMap := template.FuncMap{
@@ -163,16 +246,34 @@ func printPipelineRunDescription(s *cli.Stream, prName string, p cli.Params) err
 		"hasFailed":                 hasFailed,
 		"pipelineRefExists":         validate.PipelineRefExists,
 		"pipelineResourceRefExists": validate.PipelineResourceRefExists,
+		"hasPodConditions":          hasPodConditions,
+		"hasWaiting":                hasWaiting,
+	}
+
+	tmpl, err := printer.DescribeTemplate(f, templ)
+	if err != nil {
+		return err
+	}
+
+	t, err := template.New("Describe Pipelinerun").Funcs(funcMap).Parse(tmpl)
+	if err != nil {
+		return err
 	}
 
 	w := tabwriter.NewWriter(s.Out, 0, 5, 3, ' ', tabwriter.TabIndent)
-	t := template.Must(template.New("Describe Pipelinerun").Funcs(funcMap).Parse(templ))
 
 	if err = t.Execute(w, data); err != nil {
 		fmt.Fprintf(s.Err, "Failed to execute template")
 		return err
 	}
-	return w.Flush()
+	if err = w.Flush(); err != nil {
+		return err
+	}
+
+	if opts.Timeline {
+		return printTimeline(s.Out, p, pr, opts.Steps)
+	}
+	return nil
 }
 
 func hasFailed(pr *v1alpha1.PipelineRun) string {
@@ -221,3 +322,63 @@ func (s taskrunList) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
 func (s taskrunList) Less(i, j int) bool {
 	return s[j].Status.StartTime.Before(s[i].Status.StartTime)
 }
+
+// podDiagnostics is why the pod of a taskrun of the pipelinerun is not running
+type podDiagnostics struct {
+	TaskrunName string
+	pods.Diagnostics
+}
+
+// taskrunPodDiagnostics returns why the pods of the taskruns which are not
+// done yet are not running, skipping the pods which can not be found
+func taskrunPodDiagnostics(cs *cli.Clients, ns string, trl taskrunList) []podDiagnostics {
+	diagnostics := []podDiagnostics{}
+	for _, tr := range trl {
+		if tr.Status == nil || tr.Status.PodName == "" ||
+			!tr.Status.GetCondition(apis.ConditionSucceeded).IsUnknown() {
+			continue
+		}
+
+		pod, err := cs.Kube.CoreV1().Pods(ns).Get(tr.Status.PodName, metav1.GetOptions{})
+		if err != nil {
+			continue
+		}
+		diagnostics = append(diagnostics, podDiagnostics{
+			TaskrunName: tr.TaskrunName,
+			Diagnostics: pods.Diagnose(pod),
+		})
+	}
+	return diagnostics
+}
+
+// pipelineRunEvents returns the events about the pipelinerun, its taskruns
+// and their pods
+func pipelineRunEvents(cs *cli.Clients, pr *v1alpha1.PipelineRun, trl taskrunList) ([]pods.Event, error) {
+	refs := []corev1.ObjectReference{{Kind: "PipelineRun", Name: pr.Name}}
+	for _, tr := range trl {
+		refs = append(refs, corev1.ObjectReference{Kind: "TaskRun", Name: tr.TaskrunName})
+		if tr.Status != nil && tr.Status.PodName != "" {
+			refs = append(refs, corev1.ObjectReference{Kind: "Pod", Name: tr.Status.PodName})
+		}
+	}
+
+	return pods.Events(cs.Kube, pr.Namespace, refs...)
+}
+
+func hasPodConditions(diagnostics []podDiagnostics) bool {
+	for _, d := range diagnostics {
+		if len(d.Conditions) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func hasWaiting(diagnostics []podDiagnostics) bool {
+	for _, d := range diagnostics {
+		if len(d.Waiting) != 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -730,3 +730,346 @@ tr-1   t-1         8 minutes ago   3 minutes   Succeeded
 
 	test.AssertOutput(t, expected, actual)
 }
+
+func TestPipelineRunDescribe_timeline(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	trs := []*v1alpha1.TaskRun{
+		tb.TaskRun("tr-1", "ns",
+			tb.TaskRunStatus(
+				tb.TaskRunStartTime(clock.Now()),
+				cb.TaskRunCompletionTime(clock.Now().Add(5*time.Minute)),
+				tb.StatusCondition(apis.Condition{
+					Type:   apis.ConditionSucceeded,
+					Status: corev1.ConditionTrue,
+				}),
+			),
+		),
+		tb.TaskRun("tr-2", "ns",
+			tb.TaskRunStatus(
+				tb.TaskRunStartTime(clock.Now().Add(5*time.Minute)),
+				cb.TaskRunCompletionTime(clock.Now().Add(10*time.Minute)),
+				tb.StatusCondition(apis.Condition{
+					Type:   apis.ConditionSucceeded,
+					Status: corev1.ConditionTrue,
+				}),
+				tb.StepState(
+					cb.StepName("build"),
+					tb.StateTerminated(0),
+				),
+			),
+		),
+		tb.TaskRun("tr-3", "ns",
+			tb.TaskRunStatus(
+				tb.TaskRunStartTime(clock.Now().Add(1*time.Minute)),
+				cb.TaskRunCompletionTime(clock.Now().Add(3*time.Minute)),
+				tb.StatusCondition(apis.Condition{
+					Type:   apis.ConditionSucceeded,
+					Status: corev1.ConditionTrue,
+				}),
+			),
+		),
+	}
+	trs[1].Status.Steps[0].Terminated.StartedAt = metav1.NewTime(clock.Now().Add(6 * time.Minute))
+	trs[1].Status.Steps[0].Terminated.FinishedAt = metav1.NewTime(clock.Now().Add(9 * time.Minute))
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		Pipelines: []*v1alpha1.Pipeline{
+			tb.Pipeline("pipeline", "ns",
+				tb.PipelineSpec(
+					tb.PipelineTask("t-1", "task-1"),
+					tb.PipelineTask("t-2", "task-2", tb.RunAfter("t-1")),
+					tb.PipelineTask("t-3", "task-3"),
+				),
+			),
+		},
+		PipelineRuns: []*v1alpha1.PipelineRun{
+			tb.PipelineRun("pipeline-run", "ns",
+				cb.PipelineRunCreationTimestamp(clock.Now()),
+				tb.PipelineRunLabel("tekton.dev/pipeline", "pipeline"),
+				tb.PipelineRunSpec("pipeline"),
+				tb.PipelineRunStatus(
+					tb.PipelineRunTaskRunsStatus("tr-1", &v1alpha1.PipelineRunTaskRunStatus{
+						PipelineTaskName: "t-1",
+						Status:           &trs[0].Status,
+					}),
+					tb.PipelineRunTaskRunsStatus("tr-2", &v1alpha1.PipelineRunTaskRunStatus{
+						PipelineTaskName: "t-2",
+						Status:           &trs[1].Status,
+					}),
+					tb.PipelineRunTaskRunsStatus("tr-3", &v1alpha1.PipelineRunTaskRunStatus{
+						PipelineTaskName: "t-3",
+						Status:           &trs[2].Status,
+					}),
+					tb.PipelineRunStatusCondition(apis.Condition{
+						Status: corev1.ConditionTrue,
+						Reason: resources.ReasonSucceeded,
+					}),
+					tb.PipelineRunStartTime(clock.Now()),
+					cb.PipelineRunCompletionTime(clock.Now().Add(10*time.Minute)),
+				),
+			),
+		},
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ns",
+				},
+			},
+		},
+	})
+
+	p := &test.Params{Tekton: cs.Pipeline, Clock: clock, Kube: cs.Kube}
+	pipelinerun := Command(p)
+	clock.Advance(20 * time.Minute)
+	actual, err := test.ExecuteCommand(pipelinerun, "desc", "pipeline-run", "-n", "ns", "--timeline", "--steps")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	expected := `Name:           pipeline-run
+Namespace:      ns
+Pipeline Ref:   pipeline
+
+Status
+STARTED          DURATION     STATUS
+20 minutes ago   10 minutes   Succeeded
+
+Resources
+No resources
+
+Params
+No params
+
+Taskruns
+NAME   TASK NAME   STARTED          DURATION    STATUS
+tr-2   t-2         15 minutes ago   5 minutes   Succeeded
+tr-3   t-3         19 minutes ago   2 minutes   Succeeded
+tr-1   t-1         20 minutes ago   5 minutes   Succeeded
+
+Timeline
+NAME      TASK NAME   START        DURATION    0 - 10 minutes
+tr-1      t-1         +0 seconds   5 minutes   |####################                    |
+tr-3      t-3         +1 minute    2 minutes   |    ========                            |
+tr-2      t-2         +5 minutes   5 minutes   |                    ####################|
+  build               +6 minutes   3 minutes   |                        ============    |
+
+# critical path
+`
+	test.AssertOutput(t, expected, actual)
+}
+
+func TestPipelineRunDescribe_output(t *testing.T) {
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		PipelineRuns: []*v1alpha1.PipelineRun{
+			tb.PipelineRun("pipeline-run", "ns",
+				tb.PipelineRunLabel("tekton.dev/pipeline", "pipeline"),
+				tb.PipelineRunSpec("pipeline"),
+			),
+		},
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ns",
+				},
+			},
+		},
+	})
+
+	testParams := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "jsonpath output",
+			args:     []string{"desc", "pipeline-run", "-n", "ns", "-o", "jsonpath={.kind}/{.metadata.name}/{.spec.pipelineRef.name}"},
+			expected: "PipelineRun/pipeline-run/pipeline",
+		},
+		{
+			name:     "custom text template",
+			args:     []string{"desc", "pipeline-run", "-n", "ns", "--template", "{{ .PipelineRun.Name }}\t{{ pipelineRefExists .PipelineRun.Spec }}\n"},
+			expected: "pipeline-run   pipeline\n",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+			pipelinerun := Command(p)
+			got, err := test.ExecuteCommand(pipelinerun, tp.args...)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			test.AssertOutput(t, tp.expected, got)
+		})
+	}
+}
+
+func TestPipelineRunDescribe_pending_pods(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	trs := []*v1alpha1.TaskRun{
+		tb.TaskRun("tr-1", "ns",
+			tb.TaskRunStatus(
+				tb.TaskRunStartTime(clock.Now()),
+				cb.TaskRunCompletionTime(clock.Now().Add(1*time.Minute)),
+				tb.PodName("tr-1-pod"),
+				tb.StatusCondition(apis.Condition{
+					Type:   apis.ConditionSucceeded,
+					Status: corev1.ConditionTrue,
+				}),
+			),
+		),
+		tb.TaskRun("tr-2", "ns",
+			tb.TaskRunStatus(
+				tb.TaskRunStartTime(clock.Now().Add(1*time.Minute)),
+				tb.PodName("tr-2-pod"),
+				tb.StatusCondition(apis.Condition{
+					Type:   apis.ConditionSucceeded,
+					Status: corev1.ConditionUnknown,
+					Reason: "Pending",
+				}),
+			),
+		),
+	}
+
+	pods := []*corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tr-1-pod",
+				Namespace: "ns",
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodSucceeded,
+				Conditions: []corev1.PodCondition{
+					{
+						Type:   corev1.PodReady,
+						Status: corev1.ConditionFalse,
+						Reason: "PodCompleted",
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tr-2-pod",
+				Namespace: "ns",
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodPending,
+				Conditions: []corev1.PodCondition{
+					{
+						Type:    corev1.PodScheduled,
+						Status:  corev1.ConditionFalse,
+						Reason:  "Unschedulable",
+						Message: "0/3 nodes are available: 3 Insufficient cpu.",
+					},
+				},
+			},
+		},
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		PipelineRuns: []*v1alpha1.PipelineRun{
+			tb.PipelineRun("pipeline-run", "ns",
+				cb.PipelineRunCreationTimestamp(clock.Now()),
+				tb.PipelineRunLabel("tekton.dev/pipeline", "pipeline"),
+				tb.PipelineRunSpec("pipeline"),
+				tb.PipelineRunStatus(
+					tb.PipelineRunTaskRunsStatus("tr-1", &v1alpha1.PipelineRunTaskRunStatus{
+						PipelineTaskName: "t-1",
+						Status:           &trs[0].Status,
+					}),
+					tb.PipelineRunTaskRunsStatus("tr-2", &v1alpha1.PipelineRunTaskRunStatus{
+						PipelineTaskName: "t-2",
+						Status:           &trs[1].Status,
+					}),
+					tb.PipelineRunStatusCondition(apis.Condition{
+						Status: corev1.ConditionUnknown,
+						Reason: resources.ReasonRunning,
+					}),
+					tb.PipelineRunStartTime(clock.Now()),
+				),
+			),
+		},
+		Pods: pods,
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ns",
+				},
+			},
+		},
+	})
+
+	events := []*corev1.Event{
+		{
+			ObjectMeta:     metav1.ObjectMeta{Name: "e1", Namespace: "ns"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "tr-2-pod"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "FailedScheduling",
+			Message:        "0/3 nodes are available: 3 Insufficient cpu.",
+			LastTimestamp:  metav1.Time{Time: clock.Now().Add(2 * time.Minute)},
+		},
+		{
+			ObjectMeta:     metav1.ObjectMeta{Name: "e2", Namespace: "ns"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "tr-1-pod"},
+			Type:           corev1.EventTypeNormal,
+			Reason:         "Started",
+			Message:        "Started container step-build",
+			LastTimestamp:  metav1.Time{Time: clock.Now()},
+		},
+		{
+			ObjectMeta:     metav1.ObjectMeta{Name: "e3", Namespace: "ns"},
+			InvolvedObject: corev1.ObjectReference{Kind: "PipelineRun", Name: "other-run"},
+			Type:           corev1.EventTypeNormal,
+			Reason:         "Succeeded",
+			Message:        "All Tasks have completed executing",
+			LastTimestamp:  metav1.Time{Time: clock.Now()},
+		},
+	}
+	for _, e := range events {
+		if _, err := cs.Kube.CoreV1().Events("ns").Create(e); err != nil {
+			t.Fatalf("failed to create event: %v", err)
+		}
+	}
+
+	p := &test.Params{Tekton: cs.Pipeline, Clock: clock, Kube: cs.Kube}
+
+	pipelinerun := Command(p)
+	clock.Advance(10 * time.Minute)
+	actual, err := test.ExecuteCommand(pipelinerun, "desc", "pipeline-run", "-n", "ns")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	expected := `Name:           pipeline-run
+Namespace:      ns
+Pipeline Ref:   pipeline
+
+Status
+STARTED          DURATION   STATUS
+10 minutes ago   ---        Running
+
+Resources
+No resources
+
+Params
+No params
+
+Taskruns
+NAME   TASK NAME   STARTED          DURATION   STATUS
+tr-2   t-2         9 minutes ago    ---        Running(Pending)
+tr-1   t-1         10 minutes ago   1 minute   Succeeded
+
+Pod Conditions
+TASKRUN   TYPE           STATUS   REASON          MESSAGE
+tr-2      PodScheduled   False    Unschedulable   0/3 nodes are available: 3 Insufficient cpu.
+
+Events
+LAST SEEN        TYPE      OBJECT         REASON             MESSAGE
+10 minutes ago   Normal    pod/tr-1-pod   Started            Started container step-build
+8 minutes ago    Warning   pod/tr-2-pod   FailedScheduling   0/3 nodes are available: 3 Insufficient cpu.
+`
+
+	test.AssertOutput(t, expected, actual)
+}
@@ -0,0 +1,113 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelinerun
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
+	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/cli/pkg/helper/diff"
+	validate "github.com/tektoncd/cli/pkg/helper/validate"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func diffCommand(p cli.Params) *cobra.Command {
+	all := false
+	eg := `
+# Show what differs between the PipelineRuns 'good' and 'bad' in namespace 'bar'
+tkn pr diff good bad -n bar
+
+# Show all the fields of the PipelineRuns 'good' and 'bad' side by side
+tkn pr diff good bad -n bar --all
+`
+
+	c := &cobra.Command{
+		Use:          "diff",
+		Short:        "Show the differences between two pipelineruns",
+		Example:      eg,
+		Args:         cobra.ExactArgs(2),
+		SilenceUsage: true,
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validate.NamespaceExists(p); err != nil {
+				return err
+			}
+
+			return printPipelineRunDiff(cmd.OutOrStdout(), p, [2]string{args[0], args[1]}, all)
+		},
+	}
+
+	c.Flags().BoolVarP(&all, "all", "", false, "show all the fields, not only the ones which differ")
+
+	completion.RegisterArgs(c, 2, "pipelinerun")
+	return c
+}
+
+// printPipelineRunDiff compares the params, resources, service account and
+// status of the pipelineruns along with the status, duration, step images
+// and resource results of their taskruns
+func printPipelineRunDiff(out io.Writer, p cli.Params, prNames [2]string, all bool) error {
+	cs, err := p.Clients()
+	if err != nil {
+		return fmt.Errorf("failed to create tekton client")
+	}
+
+	f := diff.NewFields()
+	for i, prName := range prNames {
+		pr, err := cs.Tekton.TektonV1alpha1().PipelineRuns(p.Namespace()).Get(prName, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to find pipelinerun %q", prName)
+		}
+
+		f.Set(i, "pipeline", validate.PipelineRefExists(pr.Spec))
+		f.Set(i, "service account", pr.Spec.ServiceAccountName)
+		f.Set(i, "status", formatted.Condition(pr.Status.Conditions))
+		f.Set(i, "duration", formatted.Duration(pr.Status.StartTime, pr.Status.CompletionTime))
+		f.SetParams(i, "", pr.Spec.Params)
+		for _, r := range pr.Spec.Resources {
+			if err := f.SetResource(i, cs.Tekton, p.Namespace(), "", r); err != nil {
+				return err
+			}
+		}
+
+		for _, trs := range sortedTaskRunStatuses(pr) {
+			if trs.Status != nil {
+				images := diff.StepImages(cs.Kube, pr.Namespace, trs.Status, nil)
+				f.SetTaskRunStatus(i, "task "+trs.PipelineTaskName+" ", trs.Status, images)
+			}
+		}
+	}
+
+	return f.Print(out, prNames, all)
+}
+
+func sortedTaskRunStatuses(pr *v1alpha1.PipelineRun) []*v1alpha1.PipelineRunTaskRunStatus {
+	statuses := []*v1alpha1.PipelineRunTaskRunStatus{}
+	for _, trs := range pr.Status.TaskRuns {
+		statuses = append(statuses, trs)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].PipelineTaskName < statuses[j].PipelineTaskName
+	})
+	return statuses
+}
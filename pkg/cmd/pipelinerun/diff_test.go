@@ -0,0 +1,141 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelinerun
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/test"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	tb "github.com/tektoncd/pipeline/test/builder"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestPipelineRunDiff(t *testing.T) {
+	start := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	step := func(name, imageID, reason string) tb.StepStateOp {
+		return func(s *v1alpha1.StepState) {
+			s.Name = name
+			s.ImageID = imageID
+			s.Terminated = &corev1.ContainerStateTerminated{Reason: reason}
+		}
+	}
+
+	pipelineRun := func(name, revision, repo, commit string, status corev1.ConditionStatus, d time.Duration, buildImage, buildReason string) *v1alpha1.PipelineRun {
+		tr := tb.TaskRun("tr", "ns", tb.TaskRunStatus(
+			tb.StatusCondition(apis.Condition{Type: apis.ConditionSucceeded, Status: status}),
+			tb.TaskRunStartTime(start),
+			tb.TaskRunCompletionTime(start.Add(d)),
+			tb.PodName(name+"-build-pod"),
+			tb.StepState(step("build", buildImage, buildReason)),
+			func(s *v1alpha1.TaskRunStatus) {
+				s.ResourcesResult = []v1alpha1.PipelineResourceResult{
+					{Key: "commit", Value: commit, ResourceRef: v1alpha1.PipelineResourceRef{Name: "repo"}},
+				}
+			},
+		))
+		return tb.PipelineRun(name, "ns",
+			tb.PipelineRunLabel("tekton.dev/pipeline", "pipeline"),
+			tb.PipelineRunSpec("pipeline",
+				tb.PipelineRunServiceAccountName("builder"),
+				tb.PipelineRunParam("revision", revision),
+				tb.PipelineRunResourceBinding("source", tb.PipelineResourceBindingRef(repo)),
+			),
+			tb.PipelineRunStatus(
+				tb.PipelineRunStatusCondition(apis.Condition{Type: apis.ConditionSucceeded, Status: status}),
+				tb.PipelineRunStartTime(start),
+				tb.PipelineRunCompletionTime(start.Add(d)),
+				tb.PipelineRunTaskRunsStatus(name+"-build", &v1alpha1.PipelineRunTaskRunStatus{
+					PipelineTaskName: "build",
+					Status:           &tr.Status,
+				}),
+			),
+		)
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		PipelineRuns: []*v1alpha1.PipelineRun{
+			pipelineRun("good", "v1", "repo-main", "abc123", corev1.ConditionTrue, 5*time.Minute, "docker.io/golang@sha256:aaa", "Completed"),
+			pipelineRun("bad", "v2", "repo-next", "def456", corev1.ConditionFalse, 2*time.Minute, "docker.io/golang@sha256:bbb", "Error"),
+		},
+		PipelineResources: []*v1alpha1.PipelineResource{
+			tb.PipelineResource("repo-main", "ns", tb.PipelineResourceSpec(v1alpha1.PipelineResourceTypeGit,
+				tb.PipelineResourceSpecParam("revision", "main"),
+			)),
+			tb.PipelineResource("repo-next", "ns", tb.PipelineResourceSpec(v1alpha1.PipelineResourceTypeGit,
+				tb.PipelineResourceSpecParam("revision", "next"),
+			)),
+		},
+		Pods: []*corev1.Pod{
+			tb.Pod("good-build-pod", "ns", tb.PodSpec(tb.PodContainer("step-build", "golang:1.13"))),
+			tb.Pod("bad-build-pod", "ns", tb.PodSpec(tb.PodContainer("step-build", "golang:1.14"))),
+		},
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ns",
+				},
+			},
+		},
+	})
+
+	testParams := []struct {
+		name     string
+		command  []string
+		expected string
+	}{
+		{
+			name:    "differences",
+			command: []string{"diff", "good", "bad", "-n", "ns"},
+			expected: `FIELD                            good                          bad
+status                           Succeeded                     Failed
+duration                         5 minutes                     2 minutes
+param revision                   v1                            v2
+resource source                  repo-main                     repo-next
+resource source revision         main                          next
+task build status                Succeeded                     Failed
+task build duration              5 minutes                     2 minutes
+task build step build status     Completed                     Error
+task build step build image      golang:1.13                   golang:1.14
+task build step build image ID   docker.io/golang@sha256:aaa   docker.io/golang@sha256:bbb
+task build result repo commit    abc123                        def456
+`,
+		},
+		{
+			name:     "no differences",
+			command:  []string{"diff", "good", "good", "-n", "ns"},
+			expected: "No differences\n",
+		},
+		{
+			name:     "pipelinerun not found",
+			command:  []string{"diff", "good", "missing", "-n", "ns"},
+			expected: "Error: failed to find pipelinerun \"missing\"\n",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+			pipelinerun := Command(p)
+			got, _ := test.ExecuteCommand(pipelinerun, tp.command...)
+			test.AssertOutput(t, tp.expected, got)
+		})
+	}
+}
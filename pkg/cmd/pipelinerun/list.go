@@ -16,17 +16,22 @@ package pipelinerun
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"text/tabwriter"
+	"time"
 
 	"github.com/jonboulle/clockwork"
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/cli/pkg/helper/options"
 	prhsort "github.com/tektoncd/cli/pkg/helper/pipelinerun/sort"
 	validate "github.com/tektoncd/cli/pkg/helper/validate"
+	"github.com/tektoncd/cli/pkg/helper/watch"
 	"github.com/tektoncd/cli/pkg/printer"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	informers "github.com/tektoncd/pipeline/pkg/client/informers/externalversions"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	cliopts "k8s.io/cli-runtime/pkg/genericclioptions"
@@ -36,8 +41,20 @@ const (
 	emptyMsg = "No pipelineruns found"
 )
 
+// watchInterval is how often the table of the watched pipelineruns is redrawn
+// so that their age keeps ticking
+const watchInterval = time.Second
+
+// eventListenerLabels are the labels Tekton Triggers sets on the runs it
+// creates, the newest first
+var eventListenerLabels = []string{"triggers.tekton.dev/eventlistener", "tekton.dev/eventlistener"}
+
 type ListOptions struct {
-	Limit int
+	Limit     int
+	ChunkSize int64
+	Watch     bool
+	Wide      bool
+	options.ListOptions
 }
 
 func listCommand(p cli.Params) *cobra.Command {
@@ -50,6 +67,24 @@ tkn pipelinerun list foo -n bar
 
 # List all pipelineruns in a namespaces 'foo'
 tkn pr list -n foo
+
+# List the pipelineruns which failed in the last 2 days, longest first
+tkn pr list -n foo --status failed --since 2d --sort-by duration
+
+# List the pipelineruns of all the namespaces
+tkn pr list -A
+
+# Print the pipelineruns 500 at a time as they are received
+tkn pr list -n foo --chunk-size 500
+
+# Keep watching the pipelineruns of Pipeline 'foo' as they progress
+tkn pr list foo -n bar -w
+
+# List the pipelineruns with their pipeline, service account and failed task
+tkn pr list -n foo -o wide
+
+# List the pipelineruns with the columns of your choice
+tkn pr list -n foo -o custom-columns=NAME:.metadata.name,SA:.spec.serviceAccountName
 `
 
 	c := &cobra.Command{
@@ -67,7 +102,7 @@ tkn pr list -n foo
 				pipeline = args[0]
 			}
 
-			if err := validate.NamespaceExists(p); err != nil {
+			if err := opts.ValidateNamespace(p); err != nil {
 				return err
 			}
 
@@ -76,9 +111,11 @@ tkn pr list -n foo
 				return nil
 			}
 
-			prs, err := list(p, pipeline, opts.Limit)
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "Failed to list pipelineruns from %s namespace \n", p.Namespace())
+			if opts.ChunkSize < 0 {
+				return fmt.Errorf("chunk size was %d but must be a positive number", opts.ChunkSize)
+			}
+
+			if err := opts.Validate(); err != nil {
 				return err
 			}
 
@@ -88,16 +125,45 @@ tkn pr list -n foo
 				return err
 			}
 
-			if output != "" && prs != nil {
-				return printer.PrintObject(cmd.OutOrStdout(), prs, f)
-			}
 			stream := &cli.Stream{
 				Out: cmd.OutOrStdout(),
 				Err: cmd.OutOrStderr(),
 			}
 
+			if output == printer.OutputWide {
+				opts.Wide = true
+				output = ""
+			}
+
+			if opts.Watch {
+				if output != "" {
+					return fmt.Errorf("--watch can not be used with --output")
+				}
+				return watchRuns(stream, p, pipeline, opts, watch.Interrupted())
+			}
+
+			// rows are printed as the chunks are received when asked for,
+			// the limit needs all the pipelineruns sorted together
+			if output == "" && opts.ChunkSize > 0 && opts.Limit == 0 {
+				if err := printStreamed(stream, p, pipeline, opts); err != nil {
+					fmt.Fprintf(os.Stderr, "Failed to list pipelineruns from %s namespace \n", p.Namespace())
+					return err
+				}
+				return nil
+			}
+
+			prs, err := list(p, pipeline, opts)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Failed to list pipelineruns from %s namespace \n", p.Namespace())
+				return err
+			}
+
+			if output != "" && prs != nil {
+				return printer.PrintObject(cmd.OutOrStdout(), prs, f)
+			}
+
 			if prs != nil {
-				err = printFormatted(stream, prs, p.Time())
+				err = printFormatted(stream, prs, p.Time(), opts)
 			}
 
 			if err != nil {
@@ -108,37 +174,103 @@ tkn pr list -n foo
 		},
 	}
 
-	f.AddFlags(c)
+	printer.AddListFlags(c, f, printer.OutputWide)
+	opts.AddRunFlags(c)
+	opts.AddAllNamespacesFlag(c)
 	c.Flags().IntVarP(&opts.Limit, "limit", "", 0, "limit pipelineruns listed (default: return all pipelineruns)")
+	c.Flags().BoolVarP(&opts.Watch, "watch", "w", false, "watch the pipelineruns and redraw the table as they are created, progress and complete")
+	c.Flags().Int64VarP(&opts.ChunkSize, "chunk-size", "", 0, "fetch pipelineruns in chunks of this size and print them as they are received, sorted within each chunk; 0 fetches and sorts them all at once, as --limit does")
 
 	return c
 }
 
-func list(p cli.Params, pipeline string, limit int) (*v1alpha1.PipelineRunList, error) {
+// listChunks lists the pipelineruns matching the list options, chunkSize at
+// a time, and calls fn with each chunk until there is no more pipelinerun or
+// fn returns false. A chunk size of 0 lists all the pipelineruns at once.
+func listChunks(p cli.Params, pipeline string, opts *ListOptions, fn func([]v1alpha1.PipelineRun) (bool, error)) error {
 	cs, err := p.Clients()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	options := v1.ListOptions{}
+	var selector string
 	if pipeline != "" {
-		options = v1.ListOptions{
-			LabelSelector: fmt.Sprintf("tekton.dev/pipeline=%s", pipeline),
+		selector = fmt.Sprintf("tekton.dev/pipeline=%s", pipeline)
+	}
+	listOpts := v1.ListOptions{
+		LabelSelector: opts.LabelSelector(selector),
+		Limit:         opts.ChunkSize,
+	}
+
+	prc := cs.Tekton.TektonV1alpha1().PipelineRuns(opts.Namespace(p))
+	for {
+		prs, err := prc.List(listOpts)
+		if err != nil {
+			return err
+		}
+
+		more, err := fn(prs.Items)
+		if err != nil {
+			return err
+		}
+		if !more || prs.Continue == "" {
+			return nil
+		}
+		listOpts.Continue = prs.Continue
+	}
+}
+
+func filter(p cli.Params, prs []v1alpha1.PipelineRun, opts *ListOptions) []v1alpha1.PipelineRun {
+	now := p.Time().Now()
+	filtered := prs[:0]
+	for _, pr := range prs {
+		if opts.MatchRun(pr.Status.Conditions, pr.Status.StartTime, now) {
+			filtered = append(filtered, pr)
 		}
 	}
+	return filtered
+}
 
-	prc := cs.Tekton.TektonV1alpha1().PipelineRuns(p.Namespace())
-	prs, err := prc.List(options)
+func list(p cli.Params, pipeline string, opts *ListOptions) (*v1alpha1.PipelineRunList, error) {
+	prs := &v1alpha1.PipelineRunList{}
+	err := listChunks(p, pipeline, opts, func(chunk []v1alpha1.PipelineRun) (bool, error) {
+		prs.Items = append(prs.Items, filter(p, chunk, opts)...)
+		return true, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	prslen := len(prs.Items)
+	prs.Items = sortAndLimit(prs.Items, opts)
+
+	// NOTE: this is required for -o json|yaml to work properly since
+	// tektoncd go client fails to set these; probably a bug
+	prs.GetObjectKind().SetGroupVersionKind(
+		schema.GroupVersionKind{
+			Version: "tekton.dev/v1alpha1",
+			Kind:    "PipelineRunList",
+		})
+
+	return prs, nil
+}
+
+func sortAndLimit(prs []v1alpha1.PipelineRun, opts *ListOptions) []v1alpha1.PipelineRun {
+	prslen := len(prs)
 
 	if prslen != 0 {
-		prs.Items = prhsort.SortPipelineRunsByStartTime(prs.Items)
+		prs = prhsort.SortPipelineRunsByStartTime(prs)
+		switch opts.SortBy {
+		case options.SortByName:
+			prs = prhsort.SortPipelineRunsByName(prs)
+		case options.SortByDuration:
+			prs = prhsort.SortPipelineRunsByDuration(prs)
+		case options.SortByStatus:
+			prs = prhsort.SortPipelineRunsByStatus(prs)
+		}
 	}
 
+	limit := opts.Limit
+
 	// If greater than maximum amount of pipelineruns, return all pipelineruns by setting limit to default
 	if limit > prslen {
 		limit = 0
@@ -146,37 +278,160 @@ func list(p cli.Params, pipeline string, limit int) (*v1alpha1.PipelineRunList,
 
 	// Return all pipelineruns if limit is 0 or is same as prslen
 	if limit != 0 && prslen > limit {
-		prs.Items = prs.Items[0:limit]
+		prs = prs[0:limit]
 	}
+	return prs
+}
 
-	// NOTE: this is required for -o json|yaml to work properly since
-	// tektoncd go client fails to set these; probably a bug
-	prs.GetObjectKind().SetGroupVersionKind(
-		schema.GroupVersionKind{
-			Version: "tekton.dev/v1alpha1",
-			Kind:    "PipelineRunList",
-		})
+// watchRuns draws the table of the pipelineruns kept up to date by an
+// informer, and redraws it whenever they change until stop is closed
+func watchRuns(s *cli.Stream, p cli.Params, pipeline string, opts *ListOptions, stop <-chan struct{}) error {
+	cs, err := p.Clients()
+	if err != nil {
+		return err
+	}
 
-	return prs, nil
+	var selector string
+	if pipeline != "" {
+		selector = fmt.Sprintf("tekton.dev/pipeline=%s", pipeline)
+	}
+	factory := informers.NewSharedInformerFactoryWithOptions(
+		cs.Tekton,
+		0,
+		informers.WithNamespace(opts.Namespace(p)),
+		informers.WithTweakListOptions(func(o *v1.ListOptions) {
+			o.LabelSelector = opts.LabelSelector(selector)
+		}))
+	informer := factory.Tekton().V1alpha1().PipelineRuns().Informer()
+	changes := watch.Changes(informer)
+
+	factory.Start(stop)
+	factory.WaitForCacheSync(stop)
+
+	table := &watch.Table{
+		Out:      s.Out,
+		Clock:    p.Time(),
+		Interval: watchInterval,
+		Render: func(w io.Writer) error {
+			prs := &v1alpha1.PipelineRunList{}
+			for _, obj := range informer.GetStore().List() {
+				if pr, ok := obj.(*v1alpha1.PipelineRun); ok {
+					prs.Items = append(prs.Items, *pr.DeepCopy())
+				}
+			}
+			prs.Items = sortAndLimit(filter(p, prs.Items, opts), opts)
+			return printFormatted(&cli.Stream{Out: w, Err: w}, prs, p.Time(), opts)
+		},
+	}
+	return table.Run(changes, stop)
 }
 
-func printFormatted(s *cli.Stream, prs *v1alpha1.PipelineRunList, c clockwork.Clock) error {
+// printStreamed prints the pipelineruns chunk by chunk as they are received,
+// sorted within each chunk, the columns are aligned within each chunk too
+func printStreamed(s *cli.Stream, p cli.Params, pipeline string, opts *ListOptions) error {
+	w := tabwriter.NewWriter(s.Out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	count := 0
+
+	err := listChunks(p, pipeline, opts, func(chunk []v1alpha1.PipelineRun) (bool, error) {
+		prs := sortAndLimit(filter(p, chunk, opts), opts)
+
+		if count == 0 && len(prs) > 0 {
+			printHeader(w, opts)
+		}
+		printRows(w, prs, p.Time(), opts)
+		count += len(prs)
+
+		return true, w.Flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		fmt.Fprintln(s.Err, emptyMsg)
+	}
+	return nil
+}
+
+func printFormatted(s *cli.Stream, prs *v1alpha1.PipelineRunList, c clockwork.Clock, opts *ListOptions) error {
 	if len(prs.Items) == 0 {
 		fmt.Fprintln(s.Err, emptyMsg)
 		return nil
 	}
 
 	w := tabwriter.NewWriter(s.Out, 0, 5, 3, ' ', tabwriter.TabIndent)
-	fmt.Fprintln(w, "NAME\tSTARTED\tDURATION\tSTATUS\t")
-	for _, pr := range prs.Items {
+	printHeader(w, opts)
+	printRows(w, prs.Items, c, opts)
+
+	return w.Flush()
+}
+
+func printHeader(w io.Writer, opts *ListOptions) {
+	if opts.AllNamespaces {
+		fmt.Fprint(w, "NAMESPACE\t")
+	}
+	fmt.Fprint(w, "NAME\tSTARTED\tDURATION\tSTATUS\t")
+	if opts.Wide {
+		fmt.Fprint(w, "PIPELINE\tSERVICE ACCOUNT\tEVENT LISTENER\tFAILED TASK\t")
+	}
+	fmt.Fprintln(w)
+}
 
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n",
+func printRows(w io.Writer, prs []v1alpha1.PipelineRun, c clockwork.Clock, opts *ListOptions) {
+	for _, pr := range prs {
+		if opts.AllNamespaces {
+			fmt.Fprintf(w, "%s\t", pr.Namespace)
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t",
 			pr.Name,
 			formatted.Age(pr.Status.StartTime, c),
 			formatted.Duration(pr.Status.StartTime, pr.Status.CompletionTime),
 			formatted.Condition(pr.Status.Conditions),
 		)
+		if opts.Wide {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t",
+				orNone(validate.PipelineRefExists(pr.Spec)),
+				orNone(pr.Spec.ServiceAccountName),
+				orNone(eventListener(pr.Labels)),
+				orNone(failedTask(pr)),
+			)
+		}
+		fmt.Fprintln(w)
 	}
+}
 
-	return w.Flush()
+func eventListener(labels map[string]string) string {
+	for _, l := range eventListenerLabels {
+		if el, ok := labels[l]; ok {
+			return el
+		}
+	}
+	return ""
+}
+
+// failedTask returns the pipeline task of the taskrun which failed first
+func failedTask(pr v1alpha1.PipelineRun) string {
+	var failed *v1alpha1.PipelineRunTaskRunStatus
+	for _, trs := range pr.Status.TaskRuns {
+		if trs.Status == nil || options.RunStatus(trs.Status.Conditions) != options.StatusFailed {
+			continue
+		}
+		if failed == nil || trs.Status.CompletionTime.Before(failed.Status.CompletionTime) ||
+			(trs.Status.CompletionTime.Equal(failed.Status.CompletionTime) && trs.PipelineTaskName < failed.PipelineTaskName) {
+			failed = trs
+		}
+	}
+
+	if failed == nil {
+		return ""
+	}
+	return failed.PipelineTaskName
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "---"
+	}
+	return s
 }
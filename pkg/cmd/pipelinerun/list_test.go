@@ -22,6 +22,7 @@ import (
 
 	"github.com/jonboulle/clockwork"
 	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/test"
 	cb "github.com/tektoncd/cli/pkg/test/builder"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
@@ -30,6 +31,8 @@ import (
 	tb "github.com/tektoncd/pipeline/test/builder"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stest "k8s.io/client-go/testing"
 	"knative.dev/pkg/apis"
 )
 
@@ -192,6 +195,100 @@ func TestListPipelineRuns(t *testing.T) {
 				"",
 			},
 		},
+		{
+			name:      "by selector",
+			command:   command(t, prs, clock.Now(), ns),
+			args:      []string{"list", "-n", "namespace", "-l", "tekton.dev/pipeline=pipeline"},
+			wantError: false,
+			expected: []string{
+				"NAME    STARTED          DURATION   STATUS      ",
+				"pr1-1   59 minutes ago   1 minute   Succeeded   ",
+				"",
+			},
+		},
+		{
+			name:      "by status",
+			command:   command(t, prs, clock.Now(), ns),
+			args:      []string{"list", "-n", "namespace", "--status", "failed"},
+			wantError: false,
+			expected: []string{
+				"NAME    STARTED       DURATION   STATUS   ",
+				"pr2-2   2 hours ago   1 minute   Failed   ",
+				"",
+			},
+		},
+		{
+			name:      "started since",
+			command:   command(t, prs, clock.Now(), ns),
+			args:      []string{"list", "-n", "namespace", "--since", "90m"},
+			wantError: false,
+			expected: []string{
+				"NAME    STARTED          DURATION   STATUS      ",
+				"pr1-1   59 minutes ago   1 minute   Succeeded   ",
+				"",
+			},
+		},
+		{
+			name:      "started before",
+			command:   command(t, prs, clock.Now(), ns),
+			args:      []string{"list", "-n", "namespace", "--before", "90m"},
+			wantError: false,
+			expected: []string{
+				"NAME    STARTED       DURATION   STATUS               ",
+				"pr2-2   2 hours ago   1 minute   Failed               ",
+				"pr2-1   3 hours ago   ---        Succeeded(Running)   ",
+				"",
+			},
+		},
+		{
+			name:      "sort by name",
+			command:   command(t, prs, clock.Now(), ns),
+			args:      []string{"list", "-n", "namespace", "--sort-by", "name", "-o", "jsonpath={range .items[*]}{.metadata.name}{\"\\n\"}{end}"},
+			wantError: false,
+			expected: []string{
+				"pr0-1",
+				"pr1-1",
+				"pr2-1",
+				"pr2-2",
+				"pr3-1",
+				"",
+			},
+		},
+		{
+			name:      "sort by duration",
+			command:   command(t, prs, clock.Now(), ns),
+			args:      []string{"list", "-n", "namespace", "--sort-by", "duration", "--limit", "3"},
+			wantError: false,
+			expected: []string{
+				"NAME    STARTED          DURATION   STATUS      ",
+				"pr1-1   59 minutes ago   1 minute   Succeeded   ",
+				"pr2-2   2 hours ago      1 minute   Failed      ",
+				"pr0-1   ---              ---        ---         ",
+				"",
+			},
+		},
+		{
+			name:      "sort by status",
+			command:   command(t, prs, clock.Now(), ns),
+			args:      []string{"list", "-n", "namespace", "--sort-by", "status"},
+			wantError: false,
+			expected: []string{
+				"NAME    STARTED          DURATION   STATUS               ",
+				"pr0-1   ---              ---        ---                  ",
+				"pr3-1   ---              ---        ---                  ",
+				"pr2-2   2 hours ago      1 minute   Failed               ",
+				"pr1-1   59 minutes ago   1 minute   Succeeded            ",
+				"pr2-1   3 hours ago      ---        Succeeded(Running)   ",
+				"",
+			},
+		},
+		{
+			name:      "invalid status",
+			command:   command(t, prs, clock.Now(), ns),
+			args:      []string{"list", "-n", "namespace", "--status", "done"},
+			wantError: true,
+			expected:  []string{"Error: invalid status \"done\", must be one of: succeeded|failed|running|cancelled\n"},
+		},
 	}
 
 	for _, td := range tests {
@@ -238,3 +335,392 @@ func command(t *testing.T, prs []*v1alpha1.PipelineRun, now time.Time, ns []*cor
 
 	return Command(p)
 }
+
+func TestListPipelineRuns_all_namespaces(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	prs := []*v1alpha1.PipelineRun{
+		tb.PipelineRun("pr1-1", "ns-1",
+			tb.PipelineRunLabel("tekton.dev/pipeline", "pipeline"),
+			tb.PipelineRunStatus(
+				tb.PipelineRunStatusCondition(apis.Condition{
+					Status: corev1.ConditionTrue,
+					Reason: resources.ReasonSucceeded,
+				}),
+				tb.PipelineRunStartTime(clock.Now().Add(-10*time.Minute)),
+				cb.PipelineRunCompletionTime(clock.Now().Add(-9*time.Minute)),
+			),
+		),
+		tb.PipelineRun("pr1-1", "ns-2",
+			tb.PipelineRunLabel("tekton.dev/pipeline", "pipeline"),
+			tb.PipelineRunStatus(
+				tb.PipelineRunStatusCondition(apis.Condition{
+					Status: corev1.ConditionFalse,
+					Reason: resources.ReasonFailed,
+				}),
+				tb.PipelineRunStartTime(clock.Now().Add(-5*time.Minute)),
+				cb.PipelineRunCompletionTime(clock.Now().Add(-3*time.Minute)),
+			),
+		),
+		tb.PipelineRun("pr2-1", "ns-2",
+			tb.PipelineRunLabel("tekton.dev/pipeline", "other"),
+			tb.PipelineRunStatus(
+				tb.PipelineRunStatusCondition(apis.Condition{
+					Status: corev1.ConditionTrue,
+					Reason: resources.ReasonSucceeded,
+				}),
+				tb.PipelineRunStartTime(clock.Now().Add(-20*time.Minute)),
+				cb.PipelineRunCompletionTime(clock.Now().Add(-19*time.Minute)),
+			),
+		),
+	}
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{
+			name: "all namespaces",
+			args: []string{"list", "-A"},
+			expected: []string{
+				"NAMESPACE   NAME    STARTED      DURATION    STATUS      ",
+				"ns-2        pr1-1   1 hour ago   2 minutes   Failed      ",
+				"ns-1        pr1-1   1 hour ago   1 minute    Succeeded   ",
+				"ns-2        pr2-1   1 hour ago   1 minute    Succeeded   ",
+				"",
+			},
+		},
+		{
+			name: "all namespaces with limit and selector",
+			args: []string{"list", "-A", "--limit", "1", "-l", "tekton.dev/pipeline=pipeline", "--sort-by", "duration"},
+			expected: []string{
+				"NAMESPACE   NAME    STARTED      DURATION    STATUS   ",
+				"ns-2        pr1-1   1 hour ago   2 minutes   Failed   ",
+				"",
+			},
+		},
+		{
+			name: "all namespaces with output",
+			args: []string{"list", "-A", "-o", "jsonpath={range .items[*]}{.metadata.namespace}/{.metadata.name}{\"\\n\"}{end}"},
+			expected: []string{
+				"ns-2/pr1-1",
+				"ns-1/pr1-1",
+				"ns-2/pr2-1",
+				"",
+			},
+		},
+	}
+
+	for _, td := range tests {
+		t.Run(td.name, func(t *testing.T) {
+			got, err := test.ExecuteCommand(command(t, prs, clock.Now(), nil), td.args...)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			test.AssertOutput(t, strings.Join(td.expected, "\n"), got)
+		})
+	}
+}
+
+func TestListPipelineRuns_chunks(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	pipelinerun := func(name string, started time.Duration) *v1alpha1.PipelineRun {
+		return tb.PipelineRun(name, "ns",
+			tb.PipelineRunStatus(
+				tb.PipelineRunStatusCondition(apis.Condition{
+					Status: corev1.ConditionTrue,
+					Reason: resources.ReasonSucceeded,
+				}),
+				tb.PipelineRunStartTime(clock.Now().Add(-started)),
+				cb.PipelineRunCompletionTime(clock.Now().Add(-started+time.Minute)),
+			),
+		)
+	}
+	// the server returns the pipelineruns by name, not newest first
+	pages := []*v1alpha1.PipelineRunList{
+		{
+			ListMeta: metav1.ListMeta{Continue: "page-2"},
+			Items:    []v1alpha1.PipelineRun{*pipelinerun("pr-a", 30*time.Minute), *pipelinerun("pr-b", 20*time.Minute)},
+		},
+		{
+			Items: []v1alpha1.PipelineRun{*pipelinerun("pr-c", 10*time.Minute)},
+		},
+	}
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		args     []string
+		requests int
+		expected []string
+	}{
+		{
+			name:     "newest first within each chunk",
+			args:     []string{"list", "-n", "ns", "--chunk-size", "2"},
+			requests: 2,
+			expected: []string{
+				"NAME   STARTED          DURATION   STATUS      ",
+				"pr-b   20 minutes ago   1 minute   Succeeded   ",
+				"pr-a   30 minutes ago   1 minute   Succeeded   ",
+				"pr-c   10 minutes ago   1 minute   Succeeded   ",
+				"",
+			},
+		},
+		{
+			name:     "sort all the chunks before the limit",
+			args:     []string{"list", "-n", "ns", "--chunk-size", "2", "--limit", "1"},
+			requests: 2,
+			expected: []string{
+				"NAME   STARTED          DURATION   STATUS      ",
+				"pr-c   10 minutes ago   1 minute   Succeeded   ",
+				"",
+			},
+		},
+		{
+			name:     "fetch all the chunks by default",
+			args:     []string{"list", "-n", "ns"},
+			requests: 2,
+			expected: []string{
+				"NAME   STARTED          DURATION   STATUS      ",
+				"pr-c   10 minutes ago   1 minute   Succeeded   ",
+				"pr-b   20 minutes ago   1 minute   Succeeded   ",
+				"pr-a   30 minutes ago   1 minute   Succeeded   ",
+				"",
+			},
+		},
+		{
+			name:     "sort all the chunks by default",
+			args:     []string{"list", "-n", "ns", "--sort-by", "start-time"},
+			requests: 2,
+			expected: []string{
+				"NAME   STARTED          DURATION   STATUS      ",
+				"pr-c   10 minutes ago   1 minute   Succeeded   ",
+				"pr-b   20 minutes ago   1 minute   Succeeded   ",
+				"pr-a   30 minutes ago   1 minute   Succeeded   ",
+				"",
+			},
+		},
+		{
+			name:     "output all the chunks",
+			args:     []string{"list", "-n", "ns", "--chunk-size", "2", "-o", "jsonpath={range .items[*]}{.metadata.name}{\" \"}{end}"},
+			requests: 2,
+			expected: []string{"pr-c pr-b pr-a "},
+		},
+	}
+
+	for _, td := range tests {
+		t.Run(td.name, func(t *testing.T) {
+			cs, _ := test.SeedTestData(t, pipelinetest.Data{Namespaces: ns})
+			// the fake clientset drops the limit and continue token of the
+			// list options, the pages are returned in order instead
+			requests := 0
+			cs.Pipeline.PrependReactor("list", "pipelineruns", func(_ k8stest.Action) (bool, runtime.Object, error) {
+				if requests >= len(pages) {
+					return true, nil, fmt.Errorf("unexpected request %d", requests)
+				}
+				requests++
+				return true, pages[requests-1].DeepCopy(), nil
+			})
+			p := &test.Params{Tekton: cs.Pipeline, Clock: clock, Kube: cs.Kube}
+
+			got, err := test.ExecuteCommand(Command(p), td.args...)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			test.AssertOutput(t, strings.Join(td.expected, "\n"), got)
+			test.AssertOutput(t, td.requests, requests)
+		})
+	}
+}
+
+func TestListPipelineRuns_watch(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+	prs := []*v1alpha1.PipelineRun{
+		tb.PipelineRun("pr-1", "ns",
+			tb.PipelineRunLabel("tekton.dev/pipeline", "pipeline"),
+			tb.PipelineRunStatus(
+				tb.PipelineRunStatusCondition(apis.Condition{
+					Status: corev1.ConditionTrue,
+					Reason: resources.ReasonSucceeded,
+				}),
+				tb.PipelineRunStartTime(clock.Now().Add(-10*time.Minute)),
+				cb.PipelineRunCompletionTime(clock.Now().Add(-9*time.Minute)),
+			),
+		),
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{PipelineRuns: prs, Namespaces: ns})
+	p := &test.Params{Tekton: cs.Pipeline, Clock: clock, Kube: cs.Kube}
+
+	out := &test.SyncBuffer{}
+	stop := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- watchRuns(&cli.Stream{Out: out, Err: out}, p, "pipeline", &ListOptions{}, stop)
+	}()
+
+	first := strings.Join([]string{
+		"NAME   STARTED          DURATION   STATUS      ",
+		"pr-1   10 minutes ago   1 minute   Succeeded   ",
+		"",
+	}, "\n")
+	out.WaitForSuffix(t, first)
+
+	_, err := cs.Pipeline.TektonV1alpha1().PipelineRuns("ns").Create(
+		tb.PipelineRun("pr-2", "ns",
+			tb.PipelineRunLabel("tekton.dev/pipeline", "pipeline"),
+			tb.PipelineRunStatus(
+				tb.PipelineRunStatusCondition(apis.Condition{
+					Status: corev1.ConditionUnknown,
+					Reason: resources.ReasonRunning,
+				}),
+				tb.PipelineRunStartTime(clock.Now().Add(-1*time.Minute)),
+			),
+		),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	second := strings.Join([]string{
+		"NAME   STARTED          DURATION   STATUS      ",
+		"pr-2   1 minute ago     ---        Running     ",
+		"pr-1   10 minutes ago   1 minute   Succeeded   ",
+		"",
+	}, "\n")
+	out.WaitForSuffix(t, second)
+
+	close(stop)
+	if err := <-done; err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestListPipelineRuns_watch_output(t *testing.T) {
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{})
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+
+	_, err := test.ExecuteCommand(Command(p), "list", "-A", "-w", "-o", "yaml")
+	if err == nil {
+		t.Fatalf("Expected error, got nil")
+	}
+	test.AssertOutput(t, "--watch can not be used with --output", err.Error())
+}
+
+func TestListPipelineRuns_wide_and_custom_columns(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	prs := []*v1alpha1.PipelineRun{
+		tb.PipelineRun("pr-1", "ns",
+			tb.PipelineRunLabel("tekton.dev/pipeline", "pipeline"),
+			tb.PipelineRunLabel("triggers.tekton.dev/eventlistener", "github-listener"),
+			tb.PipelineRunSpec("pipeline", tb.PipelineRunServiceAccountName("builder")),
+			tb.PipelineRunStatus(
+				tb.PipelineRunStatusCondition(apis.Condition{
+					Status: corev1.ConditionFalse,
+					Reason: resources.ReasonFailed,
+				}),
+				tb.PipelineRunStartTime(clock.Now().Add(-10*time.Minute)),
+				cb.PipelineRunCompletionTime(clock.Now().Add(-8*time.Minute)),
+				tb.PipelineRunTaskRunsStatus("pr-1-build", &v1alpha1.PipelineRunTaskRunStatus{
+					PipelineTaskName: "build",
+					Status:           taskRunStatus(corev1.ConditionTrue, clock.Now().Add(-9*time.Minute)),
+				}),
+				tb.PipelineRunTaskRunsStatus("pr-1-test", &v1alpha1.PipelineRunTaskRunStatus{
+					PipelineTaskName: "test",
+					Status:           taskRunStatus(corev1.ConditionFalse, clock.Now().Add(-8*time.Minute)),
+				}),
+			),
+		),
+		tb.PipelineRun("pr-2", "ns",
+			tb.PipelineRunLabel("tekton.dev/pipeline", "pipeline"),
+			tb.PipelineRunSpec("pipeline"),
+			tb.PipelineRunStatus(
+				tb.PipelineRunStatusCondition(apis.Condition{
+					Status: corev1.ConditionUnknown,
+					Reason: resources.ReasonRunning,
+				}),
+				tb.PipelineRunStartTime(clock.Now().Add(-5*time.Minute)),
+			),
+		),
+	}
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		args      []string
+		wantError bool
+		expected  []string
+	}{
+		{
+			name: "wide",
+			args: []string{"list", "-n", "ns", "-o", "wide"},
+			expected: []string{
+				"NAME   STARTED      DURATION    STATUS    PIPELINE   SERVICE ACCOUNT   EVENT LISTENER    FAILED TASK   ",
+				"pr-2   1 hour ago   ---         Running   pipeline   ---               ---               ---           ",
+				"pr-1   1 hour ago   2 minutes   Failed    pipeline   builder           github-listener   test          ",
+				"",
+			},
+		},
+		{
+			name: "custom columns",
+			args: []string{"list", "-n", "ns", "-o", "custom-columns=NAME:.metadata.name,SA:spec.serviceAccountName,TEST:{.status.taskRuns.pr-1-test.pipelineTaskName}"},
+			expected: []string{
+				"NAME   SA        TEST",
+				"pr-2   <none>    <none>",
+				"pr-1   builder   test",
+				"",
+			},
+		},
+		{
+			name:      "invalid custom columns",
+			args:      []string{"list", "-n", "ns", "-o", "custom-columns=NAME"},
+			wantError: true,
+			expected:  []string{"Error: unexpected custom-columns spec: NAME, expected <header>:<json-path-expr>", ""},
+		},
+	}
+
+	for _, td := range tests {
+		t.Run(td.name, func(t *testing.T) {
+			got, err := test.ExecuteCommand(command(t, prs, clock.Now(), ns), td.args...)
+			if !td.wantError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			test.AssertOutput(t, strings.Join(td.expected, "\n"), got)
+		})
+	}
+}
+
+func taskRunStatus(status corev1.ConditionStatus, completed time.Time) *v1alpha1.TaskRunStatus {
+	tr := tb.TaskRun("tr", "ns",
+		tb.TaskRunStatus(
+			tb.StatusCondition(apis.Condition{
+				Type:   apis.ConditionSucceeded,
+				Status: status,
+			}),
+			tb.TaskRunCompletionTime(completed),
+		),
+	)
+	return &tr.Status
+}
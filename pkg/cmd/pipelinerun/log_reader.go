@@ -32,14 +32,15 @@ import (
 )
 
 type LogReader struct {
-	Run      string
-	Ns       string
-	Clients  *cli.Clients
-	Streamer stream.NewStreamerFunc
-	Stream   *cli.Stream
-	AllSteps bool
-	Follow   bool
-	Tasks    []string
+	Run        string
+	Ns         string
+	Clients    *cli.Clients
+	Streamer   stream.NewStreamerFunc
+	Stream     *cli.Stream
+	AllSteps   bool
+	Follow     bool
+	Timestamps bool
+	Tasks      []string
 }
 
 // Log is the data gets written to the log channel
@@ -87,7 +88,7 @@ func (lr *LogReader) readLiveLogs(pr *v1alpha1.PipelineRun) (<-chan Log, <-chan
 					defer wg.Done()
 
 					tlr := tr.NewLogReader(lr.Ns, lr.Clients, lr.Streamer,
-						int(taskNum), lr.Follow, lr.AllSteps)
+						int(taskNum), lr.Follow, lr.AllSteps, lr.Timestamps)
 					pipeLogs(logC, errC, tlr)
 				}(run, atomic.AddInt32(&taskIndex, 1))
 			}
@@ -128,7 +129,7 @@ func (lr *LogReader) readAvailableLogs(pr *v1alpha1.PipelineRun) (<-chan Log, <-
 		for i, tr := range taskRuns {
 			tlr := tr.NewLogReader(
 				lr.Ns, lr.Clients, lr.Streamer,
-				i+1, lr.Follow, lr.AllSteps)
+				i+1, lr.Follow, lr.AllSteps, lr.Timestamps)
 
 			pipeLogs(logC, errC, tlr)
 		}
@@ -16,10 +16,12 @@ package pipelinerun
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
 	"github.com/tektoncd/cli/pkg/helper/options"
 	prhelper "github.com/tektoncd/cli/pkg/helper/pipelinerun"
 	"github.com/tektoncd/cli/pkg/helper/pods"
@@ -27,6 +29,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+func init() {
+	completion.Define("pipelinerun-tasks", completeTasks)
+}
+
 func logCommand(p cli.Params) *cobra.Command {
 	opts := &options.LogOptions{Params: p}
 	eg := `
@@ -69,13 +75,39 @@ func logCommand(p cli.Params) *cobra.Command {
 
 	c.Flags().BoolVarP(&opts.AllSteps, "all", "a", false, "show all logs including init steps injected by tekton")
 	c.Flags().BoolVarP(&opts.Follow, "follow", "f", false, "stream live logs")
+	c.Flags().BoolVarP(&opts.Timestamps, "timestamps", "", false, "show the timestamp of each log line")
 	c.Flags().StringSliceVarP(&opts.Tasks, "only-tasks", "t", []string{}, "show logs for mentioned tasks only")
 	c.Flags().IntVarP(&opts.Limit, "limit", "", 5, "lists number of pipelineruns")
 
-	_ = c.MarkZshCompPositionalArgumentCustom(1, "__tkn_get_pipelinerun")
+	completion.RegisterArgs(c, 1, "pipelinerun")
+	completion.RegisterFlag(c, "only-tasks", "pipelinerun-tasks")
 	return c
 }
 
+// completeTasks completes the names of the tasks of the pipelinerun of the
+// command line being completed
+func completeTasks(p cli.Params, cmd *cobra.Command, args []string, toComplete string) ([]string, completion.Directive) {
+	if len(args) == 0 {
+		return nil, completion.DirectiveNoFileComp
+	}
+
+	cs, err := p.Clients()
+	if err != nil {
+		return nil, completion.DirectiveError
+	}
+	pr, err := cs.Tekton.TektonV1alpha1().PipelineRuns(p.Namespace()).Get(args[0], metav1.GetOptions{})
+	if err != nil {
+		return nil, completion.DirectiveError
+	}
+
+	tasks := []string{}
+	for _, tr := range pr.Status.TaskRuns {
+		tasks = append(tasks, tr.PipelineTaskName)
+	}
+	sort.Strings(tasks)
+	return tasks, completion.DirectiveNoFileComp
+}
+
 func Run(opts *options.LogOptions) error {
 	if opts.PipelineRunName == "" {
 		if err := askRunName(opts); err != nil {
@@ -92,14 +124,15 @@ func Run(opts *options.LogOptions) error {
 	}
 
 	lr := &LogReader{
-		Run:      opts.PipelineRunName,
-		Ns:       opts.Params.Namespace(),
-		Clients:  cs,
-		Streamer: streamer,
-		Stream:   opts.Stream,
-		Follow:   opts.Follow,
-		AllSteps: opts.AllSteps,
-		Tasks:    opts.Tasks,
+		Run:        opts.PipelineRunName,
+		Ns:         opts.Params.Namespace(),
+		Clients:    cs,
+		Streamer:   streamer,
+		Stream:     opts.Stream,
+		Follow:     opts.Follow,
+		AllSteps:   opts.AllSteps,
+		Timestamps: opts.Timestamps,
+		Tasks:      opts.Tasks,
 	}
 
 	logC, errC, err := lr.Read()
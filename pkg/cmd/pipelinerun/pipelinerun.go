@@ -41,6 +41,7 @@ func Command(p cli.Params) *cobra.Command {
 		logCommand(p),
 		cancelCommand(p),
 		deleteCommand(p),
+		diffCommand(p),
 	)
 
 	return c
@@ -0,0 +1,264 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelinerun
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/hako/durafmt"
+	"github.com/tektoncd/cli/pkg/cli"
+	phelper "github.com/tektoncd/cli/pkg/helper/pipeline"
+	validate "github.com/tektoncd/cli/pkg/helper/validate"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	timelineWidth = 40
+	barChar       = "="
+	criticalChar  = "#"
+)
+
+type timelineEntry struct {
+	Name     string
+	Task     string
+	Start    time.Time
+	End      time.Time
+	Critical bool
+	Steps    []timelineEntry
+}
+
+// printTimeline renders a Gantt chart of the taskruns of the pipelinerun
+// relative to its start, the taskruns on the critical path are drawn with #
+func printTimeline(out io.Writer, p cli.Params, pr *v1alpha1.PipelineRun, withSteps bool) error {
+	fmt.Fprintln(out, "\nTimeline")
+	if pr.Status.StartTime.IsZero() {
+		fmt.Fprintln(out, "No timeline")
+		return nil
+	}
+
+	now := p.Time().Now()
+	start := pr.Status.StartTime.Time
+	end := endTime(pr.Status.CompletionTime, now)
+
+	entries := []timelineEntry{}
+	for trName, trs := range pr.Status.TaskRuns {
+		if trs.Status == nil || trs.Status.StartTime.IsZero() {
+			continue
+		}
+
+		e := timelineEntry{
+			Name:  trName,
+			Task:  trs.PipelineTaskName,
+			Start: trs.Status.StartTime.Time,
+			End:   endTime(trs.Status.CompletionTime, now),
+		}
+		if withSteps {
+			e.Steps = stepEntries(trs.Status.Steps, now)
+		}
+		if e.End.After(end) {
+			end = e.End
+		}
+		entries = append(entries, e)
+	}
+
+	if len(entries) == 0 {
+		fmt.Fprintln(out, "No taskruns")
+		return nil
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Start.Equal(entries[j].Start) {
+			return entries[i].Name < entries[j].Name
+		}
+		return entries[i].Start.Before(entries[j].Start)
+	})
+	markCriticalPath(entries, pipelineGraph(p, pr))
+
+	total := end.Sub(start)
+	w := tabwriter.NewWriter(out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	fmt.Fprintf(w, "NAME\tTASK NAME\tSTART\tDURATION\t0 - %s\n", formatDuration(total))
+	for _, e := range entries {
+		fmt.Fprintf(w, "%s\t%s\t+%s\t%s\t%s\n",
+			e.Name,
+			e.Task,
+			formatDuration(e.Start.Sub(start)),
+			formatDuration(e.End.Sub(e.Start)),
+			bar(start, total, e),
+		)
+		for _, s := range e.Steps {
+			fmt.Fprintf(w, "  %s\t\t+%s\t%s\t%s\n",
+				s.Name,
+				formatDuration(s.Start.Sub(start)),
+				formatDuration(s.End.Sub(s.Start)),
+				bar(start, total, s),
+			)
+		}
+	}
+	fmt.Fprintf(w, "\n%s critical path\n", criticalChar)
+
+	return w.Flush()
+}
+
+func endTime(t *metav1.Time, now time.Time) time.Time {
+	if t.IsZero() {
+		return now
+	}
+	return t.Time
+}
+
+func formatDuration(d time.Duration) string {
+	return durafmt.ParseShort(d).String()
+}
+
+func stepEntries(steps []v1alpha1.StepState, now time.Time) []timelineEntry {
+	entries := []timelineEntry{}
+	for _, s := range steps {
+		switch {
+		case s.Terminated != nil:
+			entries = append(entries, timelineEntry{
+				Name:  s.Name,
+				Start: s.Terminated.StartedAt.Time,
+				End:   s.Terminated.FinishedAt.Time,
+			})
+		case s.Running != nil:
+			entries = append(entries, timelineEntry{
+				Name:  s.Name,
+				Start: s.Running.StartedAt.Time,
+				End:   now,
+			})
+		}
+	}
+	return entries
+}
+
+// bar draws the span of the entry over a fixed width, relative to the start
+// and total duration of the pipelinerun
+func bar(start time.Time, total time.Duration, e timelineEntry) string {
+	col := func(t time.Time) int {
+		if total <= 0 {
+			return 0
+		}
+		c := int(float64(t.Sub(start)) / float64(total) * timelineWidth)
+		if c < 0 {
+			return 0
+		}
+		if c > timelineWidth {
+			return timelineWidth
+		}
+		return c
+	}
+
+	from, to := col(e.Start), col(e.End)
+	if to <= from {
+		to = from + 1
+	}
+	if to > timelineWidth {
+		from, to = timelineWidth-1, timelineWidth
+	}
+
+	char := barChar
+	if e.Critical {
+		char = criticalChar
+	}
+	return "|" + strings.Repeat(" ", from) + strings.Repeat(char, to-from) +
+		strings.Repeat(" ", timelineWidth-to) + "|"
+}
+
+// pipelineGraph returns the graph of the pipeline the pipelinerun refers to,
+// nil if it can not be found
+func pipelineGraph(p cli.Params, pr *v1alpha1.PipelineRun) *phelper.Graph {
+	name := validate.PipelineRefExists(pr.Spec)
+	if name == "" {
+		return nil
+	}
+
+	cs, err := p.Clients()
+	if err != nil {
+		return nil
+	}
+
+	pipeline, err := cs.Tekton.TektonV1alpha1().Pipelines(pr.Namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil
+	}
+
+	g, err := phelper.BuildGraph(pipeline)
+	if err != nil {
+		return nil
+	}
+	return g
+}
+
+// markCriticalPath walks back from the taskrun finishing last, following at
+// each step the predecessor which finished last, i.e. the one which held up
+// the start of the next taskrun. Predecessors are taken from the pipeline
+// graph when available, otherwise every taskrun which finished before the
+// start of the current one is considered.
+func markCriticalPath(entries []timelineEntry, g *phelper.Graph) {
+	last := 0
+	for i, e := range entries {
+		if e.End.After(entries[last].End) {
+			last = i
+		}
+	}
+
+	byTask := map[string]int{}
+	for i, e := range entries {
+		byTask[e.Task] = i
+	}
+
+	predecessors := func(cur int) []int {
+		preds := []int{}
+		if g != nil {
+			for _, edge := range g.Edges {
+				if edge.To != entries[cur].Task {
+					continue
+				}
+				if i, ok := byTask[edge.From]; ok {
+					preds = append(preds, i)
+				}
+			}
+			return preds
+		}
+
+		for i, e := range entries {
+			if i != cur && !e.End.After(entries[cur].Start) {
+				preds = append(preds, i)
+			}
+		}
+		return preds
+	}
+
+	for cur := last; cur >= 0; {
+		entries[cur].Critical = true
+
+		next := -1
+		for _, i := range predecessors(cur) {
+			if entries[i].Critical {
+				continue
+			}
+			if next < 0 || entries[i].End.After(entries[next].End) {
+				next = i
+			}
+		}
+		cur = next
+	}
+}
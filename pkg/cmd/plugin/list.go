@@ -0,0 +1,73 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	emptyMsg = "No plugins found"
+	header   = "NAME\tPATH"
+	body     = "%s\t%s\n"
+)
+
+func listCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "Lists the tkn-* plugins found on the PATH",
+		Args:    cobra.NoArgs,
+		Annotations: map[string]string{
+			"commandType": "utility",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return printPlugins(cmd, Find(os.Getenv("PATH")))
+		},
+	}
+
+	return c
+}
+
+func printPlugins(cmd *cobra.Command, plugins []*Plugin) error {
+	if len(plugins) == 0 {
+		fmt.Fprintln(cmd.OutOrStderr(), emptyMsg)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 5, 3, ' ', tabwriter.TabIndent)
+	fmt.Fprintln(w, header)
+	for _, p := range plugins {
+		fmt.Fprintf(w, body, p.Name, p.Path)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	root := cmd.Root()
+	for _, p := range plugins {
+		for _, path := range p.Shadowed {
+			fmt.Fprintf(cmd.OutOrStderr(), "Warning: %s is shadowed by %s\n", path, p.Path)
+		}
+		if c, _, err := root.Find([]string{p.Name}); err == nil && c != root && c.Annotations["plugin"] == "" {
+			fmt.Fprintf(cmd.OutOrStderr(), "Warning: %s is shadowed by the %s command\n", p.Path, c.Name())
+		}
+	}
+	return nil
+}
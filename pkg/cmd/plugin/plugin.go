@@ -0,0 +1,119 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// Prefix is the prefix of the executables found on the PATH which are
+// run as tkn subcommands, e.g. tkn-foo is run by tkn foo
+const Prefix = "tkn-"
+
+// Plugin is an executable found on the PATH
+type Plugin struct {
+	Name string
+	Path string
+	// Shadowed are the executables with the same name found later on
+	// the PATH, which are never run
+	Shadowed []string
+}
+
+func Command() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "plugin",
+		Short: "Manage the tkn-* plugins found on the PATH",
+		Annotations: map[string]string{
+			"commandType": "utility",
+		},
+	}
+
+	c.AddCommand(listCommand())
+	return c
+}
+
+// Find returns the plugins found in the directories of path, a list of
+// directories in the format of $PATH
+func Find(path string) []*Plugin {
+	plugins := []*Plugin{}
+	found := map[string]*Plugin{}
+
+	for _, dir := range filepath.SplitList(path) {
+		if dir == "" {
+			continue
+		}
+
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, f := range files {
+			name, ok := pluginName(f.Name())
+			if !ok {
+				continue
+			}
+
+			path := filepath.Join(dir, f.Name())
+			if !isExecutable(path) {
+				continue
+			}
+
+			if p, ok := found[name]; ok {
+				p.Shadowed = append(p.Shadowed, path)
+				continue
+			}
+			p := &Plugin{Name: name, Path: path}
+			found[name] = p
+			plugins = append(plugins, p)
+		}
+	}
+
+	return plugins
+}
+
+func pluginName(file string) (string, bool) {
+	if !strings.HasPrefix(file, Prefix) {
+		return "", false
+	}
+
+	name := strings.TrimPrefix(file, Prefix)
+	if runtime.GOOS == "windows" {
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+	return name, name != ""
+}
+
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+
+	if runtime.GOOS == "windows" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".exe", ".bat", ".cmd":
+			return true
+		}
+		return false
+	}
+	return info.Mode()&0111 != 0
+}
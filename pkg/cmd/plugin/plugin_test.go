@@ -0,0 +1,197 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/test"
+)
+
+func writePlugin(t *testing.T, dir, name, script string, mode os.FileMode) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte(script), mode); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func tempDir(t *testing.T) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "tkn-plugin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestPlugin_find(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are shell scripts")
+	}
+
+	bin, local := tempDir(t), tempDir(t)
+	defer os.RemoveAll(bin)
+	defer os.RemoveAll(local)
+
+	foo := writePlugin(t, local, "tkn-foo", "#!/bin/sh\n", 0755)
+	shadowed := writePlugin(t, bin, "tkn-foo", "#!/bin/sh\n", 0755)
+	bar := writePlugin(t, bin, "tkn-bar", "#!/bin/sh\n", 0755)
+	writePlugin(t, bin, "tkn-notexecutable", "", 0644)
+	writePlugin(t, bin, "tkn-", "#!/bin/sh\n", 0755)
+	writePlugin(t, bin, "kubectl-foo", "#!/bin/sh\n", 0755)
+	if err := os.Mkdir(filepath.Join(bin, "tkn-dir"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	plugins := Find(local + string(os.PathListSeparator) + bin + string(os.PathListSeparator) + "/does/not/exist")
+	test.AssertOutput(t, []*Plugin{
+		{Name: "foo", Path: foo, Shadowed: []string{shadowed}},
+		{Name: "bar", Path: bar},
+	}, plugins)
+}
+
+func TestPlugin_list(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are shell scripts")
+	}
+
+	bin, local := tempDir(t), tempDir(t)
+	defer os.RemoveAll(bin)
+	defer os.RemoveAll(local)
+
+	path := os.Getenv("PATH")
+	defer os.Setenv("PATH", path)
+
+	root := &cobra.Command{Use: "tkn"}
+	root.AddCommand(Command(), &cobra.Command{Use: "version", Run: func(*cobra.Command, []string) {}})
+
+	os.Setenv("PATH", bin)
+	out, err := test.ExecuteCommand(root, "plugin", "list")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	test.AssertOutput(t, "No plugins found\n", out)
+
+	foo := writePlugin(t, local, "tkn-foo", "#!/bin/sh\n", 0755)
+	shadowed := writePlugin(t, bin, "tkn-foo", "#!/bin/sh\n", 0755)
+	version := writePlugin(t, bin, "tkn-version", "#!/bin/sh\n", 0755)
+	os.Setenv("PATH", local+string(os.PathListSeparator)+bin)
+
+	Add(root, Find(os.Getenv("PATH")))
+	out, err = test.ExecuteCommand(root, "plugin", "list")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	test.AssertOutput(t, "NAME      PATH\n"+
+		"foo       "+foo+"\n"+
+		"version   "+version+"\n"+
+		"Warning: "+shadowed+" is shadowed by "+foo+"\n"+
+		"Warning: "+version+" is shadowed by the version command\n", out)
+}
+
+func TestPlugin_run(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are shell scripts")
+	}
+
+	bin := tempDir(t)
+	defer os.RemoveAll(bin)
+	writePlugin(t, bin, "tkn-hello", `#!/bin/sh
+echo "args: $*"
+echo "namespace: $TKN_NAMESPACE"
+echo "context: $TKN_CONTEXT"
+echo "kubeconfig: $TKN_KUBECONFIG"
+echo "colour: $TKN_COLOUR"
+exit 3
+`, 0755)
+
+	for env, value := range map[string]string{
+		"XDG_CONFIG_HOME": bin,
+		"KUBECONFIG":      filepath.Join(bin, "kubeconfig"),
+		"TKN_CONTEXT":     "staging",
+	} {
+		old, ok := os.LookupEnv(env)
+		os.Setenv(env, value)
+		if ok {
+			defer os.Setenv(env, old)
+		} else {
+			defer os.Unsetenv(env)
+		}
+	}
+
+	root := &cobra.Command{Use: "tkn"}
+	Add(root, Find(bin))
+
+	out, err := test.ExecuteCommand(root, "hello", "-n", "foo", "--bar", "-C", "baz")
+	if err == nil || err.Error() != "exit status 3" {
+		t.Errorf("expected the exit status of the plugin, got %v", err)
+	}
+	test.AssertOutput(t, "args: -n foo --bar -C baz\n"+
+		"namespace: foo\n"+
+		"context: staging\n"+
+		"kubeconfig: "+filepath.Join(bin, "kubeconfig")+"\n"+
+		"colour: false\n", out)
+}
+
+func TestPlugin_addFor(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are shell scripts")
+	}
+
+	bin := tempDir(t)
+	defer os.RemoveAll(bin)
+	writePlugin(t, bin, "tkn-hello", "#!/bin/sh\n", 0755)
+
+	path := os.Getenv("PATH")
+	defer os.Setenv("PATH", path)
+	os.Setenv("PATH", bin)
+
+	newRoot := func() *cobra.Command {
+		root := &cobra.Command{Use: "tkn"}
+		root.AddCommand(&cobra.Command{Use: "version", Run: func(*cobra.Command, []string) {}})
+		return root
+	}
+	hasPlugin := func(root *cobra.Command) bool {
+		for _, c := range root.Commands() {
+			if c.Name() == "hello" {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, args := range [][]string{{"version"}, {"version", "-o", "json"}} {
+		root := newRoot()
+		AddFor(root, args)
+		if hasPlugin(root) {
+			t.Errorf("plugins added to run %v", args)
+		}
+	}
+
+	for _, args := range [][]string{{"hello", "world"}, {}, {"--help"}} {
+		root := newRoot()
+		AddFor(root, args)
+		if !hasPlugin(root) {
+			t.Errorf("plugins not added to run %v", args)
+		}
+	}
+}
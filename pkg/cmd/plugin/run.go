@@ -0,0 +1,162 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strconv"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/tektoncd/cli/pkg/config"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// AddFor adds the plugins found on the PATH to root when args don't run one
+// of its commands, i.e. when they run a plugin or print the help of root.
+// The PATH is not scanned to run the other commands, e.g. to complete them.
+func AddFor(root *cobra.Command, args []string) {
+	if c, _, err := root.Find(args); err == nil && c != root {
+		return
+	}
+	Add(root, Find(os.Getenv("PATH")))
+}
+
+// Add adds a command running each of the plugins to root, the plugins
+// named after a command of root are skipped
+func Add(root *cobra.Command, plugins []*Plugin) {
+	for _, p := range plugins {
+		if isBuiltin(root, p.Name) {
+			continue
+		}
+		root.AddCommand(runCommand(p))
+	}
+}
+
+func isBuiltin(root *cobra.Command, name string) bool {
+	for _, c := range root.Commands() {
+		if c.Annotations["plugin"] == "" && (c.Name() == name || c.HasAlias(name)) {
+			return true
+		}
+	}
+	return false
+}
+
+func runCommand(p *Plugin) *cobra.Command {
+	return &cobra.Command{
+		Use:   p.Name,
+		Short: fmt.Sprintf("Runs the %s%s plugin", Prefix, p.Name),
+		Annotations: map[string]string{
+			"commandType": "utility",
+			"plugin":      p.Path,
+		},
+		// the flags, --help included, are the plugin's business
+		DisableFlagParsing: true,
+		SilenceUsage:       true,
+		SilenceErrors:      true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			env, err := Env(args)
+			if err != nil {
+				fmt.Fprintf(cmd.OutOrStderr(), "Error: %s\n", err)
+				return err
+			}
+
+			c := exec.Command(p.Path, args...)
+			c.Env = append(os.Environ(), env...)
+			c.Stdin = os.Stdin
+			c.Stdout = cmd.OutOrStdout()
+			c.Stderr = cmd.OutOrStderr()
+
+			err = c.Run()
+			if _, ok := err.(*exec.ExitError); !ok && err != nil {
+				fmt.Fprintf(cmd.OutOrStderr(), "Error: failed to run %s: %s\n", p.Path, err)
+			}
+			return err
+		},
+	}
+}
+
+// Env returns the TKN_KUBECONFIG, TKN_CONTEXT, TKN_NAMESPACE and TKN_COLOUR
+// environment variables for a plugin run with args. They are resolved as
+// for the tkn commands, from the global flags found in args, the TKN_*
+// environment variables, the configuration files and then the kubeconfig, so
+// that a plugin running tkn passes its settings on
+func Env(args []string) ([]string, error) {
+	var kubeConfig, kubeContext, namespace string
+	var nocolour bool
+
+	// the plugin parses args itself, this only picks the global flags tkn
+	// knows about and ignores the others
+	f := pflag.NewFlagSet(Prefix, pflag.ContinueOnError)
+	f.ParseErrorsWhitelist.UnknownFlags = true
+	f.SetOutput(ioutil.Discard)
+	f.StringVarP(&kubeConfig, "kubeconfig", "k", "", "")
+	f.StringVarP(&kubeContext, "context", "c", "", "")
+	f.StringVarP(&namespace, "namespace", "n", "", "")
+	f.BoolVarP(&nocolour, "nocolour", "C", false, "")
+	_ = f.Parse(args)
+
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	if kubeContext == "" {
+		kubeContext = cfg.Context
+	}
+	if namespace == "" {
+		namespace = cfg.Namespace
+	}
+	if !f.Changed("nocolour") {
+		nocolour = color.NoColor
+		if cfg.Colour != nil {
+			nocolour = !*cfg.Colour
+		}
+	}
+
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = kubeConfig
+	if kubeConfig == "" {
+		kubeConfig = os.Getenv(clientcmd.RecommendedConfigPathEnvVar)
+	}
+	if kubeConfig == "" {
+		kubeConfig = clientcmd.RecommendedHomeFile
+	}
+
+	// the kubeconfig may not exist, the plugin might not need a cluster
+	kc := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules,
+		&clientcmd.ConfigOverrides{CurrentContext: kubeContext})
+	if kubeContext == "" {
+		if raw, err := kc.RawConfig(); err == nil {
+			kubeContext = raw.CurrentContext
+		}
+	}
+	if namespace == "" {
+		if ns, _, err := kc.Namespace(); err == nil {
+			namespace = ns
+		}
+	}
+
+	return []string{
+		"TKN_KUBECONFIG=" + kubeConfig,
+		"TKN_CONTEXT=" + kubeContext,
+		"TKN_NAMESPACE=" + namespace,
+		"TKN_COLOUR=" + strconv.FormatBool(!nocolour),
+	}, nil
+}
@@ -20,15 +20,19 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/alias"
 	"github.com/tektoncd/cli/pkg/cmd/clustertask"
 	"github.com/tektoncd/cli/pkg/cmd/completion"
 	"github.com/tektoncd/cli/pkg/cmd/condition"
+	"github.com/tektoncd/cli/pkg/cmd/export"
 	"github.com/tektoncd/cli/pkg/cmd/pipeline"
 	"github.com/tektoncd/cli/pkg/cmd/pipelineresource"
 	"github.com/tektoncd/cli/pkg/cmd/pipelinerun"
+	"github.com/tektoncd/cli/pkg/cmd/plugin"
 	"github.com/tektoncd/cli/pkg/cmd/task"
 	"github.com/tektoncd/cli/pkg/cmd/taskrun"
 	"github.com/tektoncd/cli/pkg/cmd/version"
+	"github.com/tektoncd/cli/pkg/config"
 )
 
 const usageTemplate = `Usage:{{if .Runnable}}
@@ -45,6 +49,9 @@ Available Commands:{{range .Commands}}{{if (eq .Annotations.commandType "main")}
   {{rpad .Name .NamePadding }} {{.Short}}{{end}}{{end}}{{end}}{{if HasUtilitySubCommands .}}
 
 Other Commands:{{range .Commands}}{{if (eq .Annotations.commandType "utility")}}
+  {{rpad .Name .NamePadding }} {{.Short}}{{end}}{{end}}{{end}}{{if HasAliasSubCommands .}}
+
+Alias Commands:{{range .Commands}}{{if (eq .Annotations.commandType "alias")}}
   {{rpad .Name .NamePadding }} {{.Short}}{{end}}{{end}}{{end}}{{end}}{{if .HasAvailableLocalFlags}}
 
 Flags:
@@ -72,10 +79,12 @@ func Root(p cli.Params) *cobra.Command {
 	}
 	cobra.AddTemplateFunc("HasMainSubCommands", hasMainSubCommands)
 	cobra.AddTemplateFunc("HasUtilitySubCommands", hasUtilitySubCommands)
+	cobra.AddTemplateFunc("HasAliasSubCommands", hasAliasSubCommands)
 	cmd.SetUsageTemplate(usageTemplate)
 
 	cmd.AddCommand(
 		completion.Command(),
+		completion.CompleteCommand(p),
 		pipeline.Command(p),
 		pipelinerun.Command(p),
 		task.Command(p),
@@ -83,9 +92,18 @@ func Root(p cli.Params) *cobra.Command {
 		pipelineresource.Command(p),
 		clustertask.Command(p),
 		condition.Command(p),
-		version.Command(),
+		export.Command(p),
+		version.Command(p),
+		plugin.Command(),
+		alias.Command(),
 	)
 
+	// the aliases are expanded by the caller before executing the command, an
+	// invalid configuration is reported by the commands reading it
+	if cfg, err := config.Load(); err == nil {
+		alias.Add(cmd, cfg.Aliases)
+	}
+
 	return cmd
 }
 
@@ -95,6 +113,9 @@ func hasMainSubCommands(cmd *cobra.Command) bool {
 func hasUtilitySubCommands(cmd *cobra.Command) bool {
 	return len(subCommands(cmd, "utility")) > 0
 }
+func hasAliasSubCommands(cmd *cobra.Command) bool {
+	return len(subCommands(cmd, "alias")) > 0
+}
 
 func subCommands(cmd *cobra.Command, annotation string) []*cobra.Command {
 	cmds := []*cobra.Command{}
@@ -15,12 +15,17 @@
 package cmd
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/spf13/pflag"
+	"github.com/tektoncd/cli/pkg/cmd/alias"
 	"github.com/tektoncd/cli/pkg/test"
 	tu "github.com/tektoncd/cli/pkg/test"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 func TestCommand_no_global_flags(t *testing.T) {
@@ -49,3 +54,45 @@ func TestCommand_suggest(t *testing.T) {
 	expected := "unknown command \"pi\" for \"tkn\"\n\nDid you mean this?\n\tpipeline\n\tpipelinerun\n"
 	tu.AssertOutput(t, expected, err.Error())
 }
+
+func TestCommand_aliases(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tkn-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := os.MkdirAll(filepath.Join(dir, "tkn"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	config := "aliases:\n  v: version\n  version: pipelinerun list\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "tkn", "config.yaml"), []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv("XDG_CONFIG_HOME", dir)
+	defer os.Unsetenv("XDG_CONFIG_HOME")
+
+	out, err := test.ExecuteCommand(Root(&test.Params{}), "--help")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "Alias Commands:\n  v           version\n") {
+		t.Errorf("alias not listed in the help: %s", out)
+	}
+
+	// the alias is expanded before executing the command
+	tkn := Root(&test.Params{Kube: fake.NewSimpleClientset()})
+	args, err := alias.Expand(tkn, []string{"v"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tu.AssertOutput(t, []string{"version"}, args)
+
+	out, err = test.ExecuteCommand(tkn, args...)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "Client version: dev\nPipeline version: unknown\n" +
+		"Warning: the tekton.dev API is not served by the cluster, is Tekton Pipelines installed?\n"
+	tu.AssertOutput(t, expected, out)
+}
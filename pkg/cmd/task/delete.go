@@ -19,8 +19,11 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
 	"github.com/tektoncd/cli/pkg/helper/options"
+	phelper "github.com/tektoncd/cli/pkg/helper/pipeline"
 	validate "github.com/tektoncd/cli/pkg/helper/validate"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	cliopts "k8s.io/cli-runtime/pkg/genericclioptions"
 )
@@ -33,12 +36,15 @@ func deleteCommand(p cli.Params) *cobra.Command {
 tkn task delete foo -n bar
 
 tkn t rm foo -n bar
+
+# Delete the Tasks 'foo' and 'baz' along with their TaskRuns, waiting for them to be gone
+tkn t rm foo baz -n bar -a --wait
 `
 
 	c := &cobra.Command{
 		Use:          "delete",
 		Aliases:      []string{"rm"},
-		Short:        "Delete a task resource in a namespace",
+		Short:        "Delete task resources in a namespace",
 		Example:      eg,
 		Args:         cobra.MinimumNArgs(1),
 		SilenceUsage: true,
@@ -52,22 +58,38 @@ tkn t rm foo -n bar
 				Err: cmd.OutOrStderr(),
 			}
 
+			if err := opts.ValidateCascade(); err != nil {
+				return err
+			}
+
 			if err := validate.NamespaceExists(p); err != nil {
 				return err
 			}
 
-			if err := opts.CheckOptions(s, args[0]); err != nil {
+			cs, err := p.Clients()
+			if err != nil {
+				return fmt.Errorf("failed to create tekton client")
+			}
+
+			if opts.Dependents, err = phelper.TaskDependents(cs.Tekton, p.Namespace(), v1alpha1.NamespacedTaskKind, args); err != nil {
+				return fmt.Errorf("failed to look up the pipelines using the tasks: %s", err)
+			}
+
+			if err := opts.CheckOptions(s, args...); err != nil {
 				return err
 			}
 
-			return deleteTask(opts, s, p, args[0])
+			return opts.DeleteEach(s, args, func(name string) error {
+				return deleteTask(opts, s, p, name)
+			})
 		},
 	}
 	f.AddFlags(c)
-	c.Flags().BoolVarP(&opts.ForceDelete, "force", "f", false, "Whether to force deletion (default: false)")
+	c.Flags().BoolVarP(&opts.ForceDelete, "force", "f", false, "Whether to force deletion, even of tasks used by pipelines (default: false)")
+	opts.AddCascadeFlags(c)
 	c.Flags().BoolVarP(&opts.DeleteAll, "all", "a", false, "Whether to delete related resources (taskruns) (default: false)")
 
-	_ = c.MarkZshCompPositionalArgumentCustom(1, "__tkn_get_task")
+	completion.RegisterArgs(c, 0, "task")
 	return c
 }
 
@@ -77,9 +99,15 @@ func deleteTask(opts *options.DeleteOptions, s *cli.Stream, p cli.Params, tName
 		return fmt.Errorf("failed to create tekton client")
 	}
 
-	if err := cs.Tekton.TektonV1alpha1().Tasks(p.Namespace()).Delete(tName, &metav1.DeleteOptions{}); err != nil {
+	if err := cs.Tekton.TektonV1alpha1().Tasks(p.Namespace()).Delete(tName, opts.KubeDeleteOptions()); err != nil {
 		return fmt.Errorf("failed to delete task %q: %s", tName, err)
 	}
+	if err := opts.WaitDeleted(func() error {
+		_, err := cs.Tekton.TektonV1alpha1().Tasks(p.Namespace()).Get(tName, metav1.GetOptions{})
+		return err
+	}); err != nil {
+		return fmt.Errorf("failed to wait for task %q to be deleted: %s", tName, err)
+	}
 	fmt.Fprintf(s.Out, "Task deleted: %s\n", tName)
 
 	if !opts.DeleteAll {
@@ -96,7 +124,7 @@ func deleteTask(opts *options.DeleteOptions, s *cli.Stream, p cli.Params, tName
 	}
 
 	for _, tr := range taskRuns.Items {
-		if err := cs.Tekton.TektonV1alpha1().TaskRuns(p.Namespace()).Delete(tr.Name, &metav1.DeleteOptions{}); err != nil {
+		if err := cs.Tekton.TektonV1alpha1().TaskRuns(p.Namespace()).Delete(tr.Name, opts.KubeDeleteOptions()); err != nil {
 			return fmt.Errorf("failed to delete taskrun %q: %s", tr.Name, err)
 		}
 
@@ -172,3 +172,69 @@ func TestTaskDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskDelete_dependents(t *testing.T) {
+	seeds := make([]pipelinetest.Clients, 0)
+	for i := 0; i < 2; i++ {
+		cs, _ := test.SeedTestData(t, pipelinetest.Data{
+			Pipelines: []*v1alpha1.Pipeline{
+				tb.Pipeline("ci", "ns", tb.PipelineSpec(tb.PipelineTask("build", "build"))),
+				tb.Pipeline("release", "ns", tb.PipelineSpec(
+					tb.PipelineTask("build", "build"),
+					tb.PipelineTask("test", "test", tb.PipelineTaskRefKind(v1alpha1.ClusterTaskKind)),
+				)),
+			},
+			Tasks: []*v1alpha1.Task{
+				tb.Task("build", "ns"),
+				tb.Task("test", "ns"),
+			},
+			Namespaces: []*corev1.Namespace{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "ns",
+					},
+				},
+			},
+		})
+		seeds = append(seeds, cs)
+	}
+
+	testParams := []struct {
+		name      string
+		command   []string
+		input     pipelinetest.Clients
+		wantError bool
+		want      string
+	}{
+		{
+			name:      "Refuse to delete a task used by pipelines",
+			command:   []string{"rm", "build", "test", "-n", "ns"},
+			input:     seeds[0],
+			wantError: true,
+			want:      "task \"build\" is used by: pipeline/ci, pipeline/release\nError: refusing to delete task \"build\" still in use, use --force to delete anyway\n",
+		},
+		{
+			name:      "Force the deletion of a task used by pipelines",
+			command:   []string{"rm", "build", "test", "-n", "ns", "-f"},
+			input:     seeds[1],
+			wantError: false,
+			want:      "task \"build\" is used by: pipeline/ci, pipeline/release\nTask deleted: build\nTask deleted: test\n",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			p := &test.Params{Tekton: tp.input.Pipeline, Kube: tp.input.Kube}
+			task := Command(p)
+
+			out, err := test.ExecuteCommand(task, tp.command...)
+			if tp.wantError && err == nil {
+				t.Errorf("error expected here")
+			}
+			if !tp.wantError && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			test.AssertOutput(t, tp.want, out)
+		})
+	}
+}
@@ -16,6 +16,7 @@ package task
 
 import (
 	"fmt"
+	"os"
 	"sort"
 	"text/tabwriter"
 	"text/template"
@@ -23,10 +24,13 @@ import (
 	"github.com/jonboulle/clockwork"
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
 	"github.com/tektoncd/cli/pkg/formatted"
 	validate "github.com/tektoncd/cli/pkg/helper/validate"
+	"github.com/tektoncd/cli/pkg/printer"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	cliopts "k8s.io/cli-runtime/pkg/genericclioptions"
 )
 
@@ -133,16 +137,49 @@ tkn t desc foo -n bar
 				return err
 			}
 
-			return printTaskDescription(s, p, args[0])
+			output, err := cmd.LocalFlags().GetString("output")
+			if err != nil {
+				fmt.Fprint(os.Stderr, "Error: output option not set properly \n")
+				return err
+			}
+
+			if output != "" {
+				return printTaskObj(s, p, args[0], f)
+			}
+
+			return printTaskDescription(s, p, args[0], f)
 		},
 	}
 
-	_ = c.MarkZshCompPositionalArgumentCustom(1, "__tkn_get_task")
+	completion.RegisterArgs(c, 1, "task")
 	f.AddFlags(c)
 	return c
 }
 
-func printTaskDescription(s *cli.Stream, p cli.Params, tname string) error {
+func printTaskObj(s *cli.Stream, p cli.Params, tname string, f *cliopts.PrintFlags) error {
+	cs, err := p.Clients()
+	if err != nil {
+		return fmt.Errorf("failed to create tekton client")
+	}
+
+	task, err := cs.Tekton.TektonV1alpha1().Tasks(p.Namespace()).Get(tname, metav1.GetOptions{})
+	if err != nil {
+		fmt.Fprintf(s.Err, "failed to get task %s\n", tname)
+		return err
+	}
+
+	// NOTE: this is required for -o json|yaml to work properly since
+	// tektoncd go client fails to set these; probably a bug
+	task.GetObjectKind().SetGroupVersionKind(
+		schema.GroupVersionKind{
+			Version: "tekton.dev/v1alpha1",
+			Kind:    "Task",
+		})
+
+	return printer.PrintObject(s.Out, task, f)
+}
+
+func printTaskDescription(s *cli.Stream, p cli.Params, tname string, f *cliopts.PrintFlags) error {
 	cs, err := p.Clients()
 	if err != nil {
 		return fmt.Errorf("failed to create tekton client")
@@ -187,14 +224,23 @@ func printTaskDescription(s *cli.Stream, p cli.Params, tname string) error {
 		"formatCondition": formatted.Condition,
 	}
 
+	tmpl, err := printer.DescribeTemplate(f, describeTemplate)
+	if err != nil {
+		return err
+	}
+
+	t, err := template.New("Describe Task").Funcs(funcMap).Parse(tmpl)
+	if err != nil {
+		return err
+	}
+
 	w := tabwriter.NewWriter(s.Out, 0, 5, 3, ' ', tabwriter.TabIndent)
-	t := template.Must(template.New("Describe Task").Funcs(funcMap).Parse(describeTemplate))
 	err = t.Execute(w, data)
 	if err != nil {
 		fmt.Fprintf(s.Err, "Failed to execute template \n")
 		return err
 	}
-	return nil
+	return w.Flush()
 }
 
 // this will sort the Task Resource by Type and then by Name
@@ -360,3 +360,51 @@ func TestTaskDescribe_PipelineRunError(t *testing.T) {
 	test.AssertOutput(t, expected, out)
 	test.AssertOutput(t, "fake list taskrun error", err.Error())
 }
+
+func TestTaskDescribe_output(t *testing.T) {
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		Tasks: []*v1alpha1.Task{
+			tb.Task("task-1", "ns",
+				tb.TaskSpec(
+					tb.Step("hello", "busybox"),
+				),
+			),
+		},
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ns",
+				},
+			},
+		},
+	})
+
+	testParams := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "jsonpath output",
+			args:     []string{"desc", "task-1", "-n", "ns", "-o", "jsonpath={.kind}/{.metadata.name}/{.spec.steps[0].image}"},
+			expected: "Task/task-1/busybox",
+		},
+		{
+			name:     "custom text template",
+			args:     []string{"desc", "task-1", "-n", "ns", "--template", "{{ .Task.Name }}\t{{ len .TaskRuns.Items }}\n"},
+			expected: "task-1   0\n",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+			task := Command(p)
+			got, err := test.ExecuteCommand(task, tp.args...)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			test.AssertOutput(t, tp.expected, got)
+		})
+	}
+}
@@ -23,7 +23,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/formatted"
-	validate "github.com/tektoncd/cli/pkg/helper/validate"
+	"github.com/tektoncd/cli/pkg/helper/options"
 	"github.com/tektoncd/cli/pkg/printer"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
 	"github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
@@ -39,6 +39,7 @@ const (
 )
 
 func listCommand(p cli.Params) *cobra.Command {
+	opts := &options.ListOptions{}
 	f := cliopts.NewPrintFlags("list")
 
 	c := &cobra.Command{
@@ -50,7 +51,11 @@ func listCommand(p cli.Params) *cobra.Command {
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 
-			if err := validate.NamespaceExists(p); err != nil {
+			if err := opts.ValidateNamespace(p); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
 				return err
 			}
 
@@ -61,27 +66,29 @@ func listCommand(p cli.Params) *cobra.Command {
 			}
 
 			if output != "" {
-				return printTaskListObj(cmd.OutOrStdout(), p, f)
+				return printTaskListObj(cmd.OutOrStdout(), p, f, opts)
 			}
 			stream := &cli.Stream{
 				Out: cmd.OutOrStdout(),
 				Err: cmd.OutOrStderr(),
 			}
-			return printTaskDetails(stream, p)
+			return printTaskDetails(stream, p, opts)
 		},
 	}
-	f.AddFlags(c)
+	printer.AddListFlags(c, f)
+	opts.AddFlags(c)
+	opts.AddAllNamespacesFlag(c)
 
 	return c
 }
 
-func printTaskDetails(s *cli.Stream, p cli.Params) error {
+func printTaskDetails(s *cli.Stream, p cli.Params, opts *options.ListOptions) error {
 	cs, err := p.Clients()
 	if err != nil {
 		return fmt.Errorf("failed to create tekton client")
 	}
 
-	tasks, err := listAllTasks(cs.Tekton, p.Namespace())
+	tasks, err := listAllTasks(cs.Tekton, opts.Namespace(p), opts)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to list tasks from %s namespace \n", p.Namespace())
 		return err
@@ -93,9 +100,15 @@ func printTaskDetails(s *cli.Stream, p cli.Params) error {
 	}
 
 	w := tabwriter.NewWriter(s.Out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	if opts.AllNamespaces {
+		fmt.Fprint(w, "NAMESPACE\t")
+	}
 	fmt.Fprintln(w, header)
 
 	for _, task := range tasks.Items {
+		if opts.AllNamespaces {
+			fmt.Fprintf(w, "%s\t", task.Namespace)
+		}
 		fmt.Fprintf(w, body,
 			task.Name,
 			formatted.Age(&task.CreationTimestamp, p.Time()),
@@ -104,27 +117,33 @@ func printTaskDetails(s *cli.Stream, p cli.Params) error {
 	return w.Flush()
 }
 
-func printTaskListObj(w io.Writer, p cli.Params, f *cliopts.PrintFlags) error {
+func printTaskListObj(w io.Writer, p cli.Params, f *cliopts.PrintFlags, opts *options.ListOptions) error {
 	cs, err := p.Clients()
 	if err != nil {
 		return err
 	}
 
-	tasks, err := listAllTasks(cs.Tekton, p.Namespace())
+	tasks, err := listAllTasks(cs.Tekton, opts.Namespace(p), opts)
 	if err != nil {
 		return err
 	}
 	return printer.PrintObject(w, tasks, f)
 }
 
-func listAllTasks(cs versioned.Interface, ns string) (*v1alpha1.TaskList, error) {
+func listAllTasks(cs versioned.Interface, ns string, opts *options.ListOptions) (*v1alpha1.TaskList, error) {
 	c := cs.TektonV1alpha1().Tasks(ns)
 
-	tasks, err := c.List(metav1.ListOptions{})
+	tasks, err := c.List(metav1.ListOptions{
+		LabelSelector: opts.LabelSelector(),
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	opts.SortObjects(tasks.Items, func(i int) metav1.Object {
+		return &tasks.Items[i]
+	})
+
 	// NOTE: this is required for -o json|yaml to work properly since
 	// tektoncd go client fails to set these; probably a bug
 	tasks.GetObjectKind().SetGroupVersionKind(
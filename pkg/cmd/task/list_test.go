@@ -104,3 +104,92 @@ func TestTaskList_Only_Tasks(t *testing.T) {
 		t.Errorf("Unexpected output mismatch: %s", d)
 	}
 }
+
+func TestTaskList_Selector_Sort(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	tasks := []*v1alpha1.Task{
+		tb.Task("tomatoes", "namespace", cb.TaskLabel("kind", "fruit"), cb.TaskCreationTime(clock.Now().Add(-1*time.Minute))),
+		tb.Task("mangoes", "namespace", cb.TaskLabel("kind", "fruit"), cb.TaskCreationTime(clock.Now().Add(-20*time.Second))),
+		tb.Task("carrots", "namespace", cb.TaskLabel("kind", "vegetable"), cb.TaskCreationTime(clock.Now().Add(-2*time.Minute))),
+		tb.Task("bananas", "namespace", cb.TaskLabel("kind", "fruit"), cb.TaskCreationTime(clock.Now().Add(-512*time.Hour))),
+	}
+
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "namespace",
+			},
+		},
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{Tasks: tasks, Namespaces: ns})
+	p := &test.Params{Tekton: cs.Pipeline, Clock: clock, Kube: cs.Kube}
+
+	testParams := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{
+			name: "by selector sorted by name",
+			args: []string{"list", "-n", "namespace", "-l", "kind=fruit", "--sort-by", "name"},
+			expected: []string{
+				"NAME       AGE",
+				"bananas    3 weeks ago",
+				"mangoes    20 seconds ago",
+				"tomatoes   1 minute ago",
+				"",
+			},
+		},
+		{
+			name: "sorted by creation time",
+			args: []string{"list", "-n", "namespace", "--sort-by", "creation-time"},
+			expected: []string{
+				"NAME       AGE",
+				"mangoes    20 seconds ago",
+				"tomatoes   1 minute ago",
+				"carrots    2 minutes ago",
+				"bananas    3 weeks ago",
+				"",
+			},
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			task := Command(p)
+			output, err := test.ExecuteCommand(task, tp.args...)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			test.AssertOutput(t, strings.Join(tp.expected, "\n"), output)
+		})
+	}
+}
+
+func TestTaskList_All_Namespaces(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	tasks := []*v1alpha1.Task{
+		tb.Task("tomatoes", "namespace", cb.TaskCreationTime(clock.Now().Add(-1*time.Minute))),
+		tb.Task("mangoes", "other", cb.TaskCreationTime(clock.Now().Add(-20*time.Second))),
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{Tasks: tasks})
+	p := &test.Params{Tekton: cs.Pipeline, Clock: clock, Kube: cs.Kube}
+
+	task := Command(p)
+	output, err := test.ExecuteCommand(task, "list", "--all-namespaces", "--sort-by", "name", "-n", "invalid")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	expected := []string{
+		"NAMESPACE   NAME       AGE",
+		"other       mangoes    20 seconds ago",
+		"namespace   tomatoes   1 minute ago",
+		"",
+	}
+	test.AssertOutput(t, strings.Join(expected, "\n"), output)
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
 	"github.com/tektoncd/cli/pkg/cmd/taskrun"
 	"github.com/tektoncd/cli/pkg/flags"
 	"github.com/tektoncd/cli/pkg/helper/options"
@@ -91,9 +92,10 @@ func logCommand(p cli.Params) *cobra.Command {
 	c.Flags().BoolVarP(&opts.Last, "last", "L", false, "show logs for last taskrun")
 	c.Flags().BoolVarP(&opts.AllSteps, "all", "a", false, "show all logs including init steps injected by tekton")
 	c.Flags().BoolVarP(&opts.Follow, "follow", "f", false, "stream live logs")
+	c.Flags().BoolVarP(&opts.Timestamps, "timestamps", "", false, "show the timestamp of each log line")
 	c.Flags().IntVarP(&opts.Limit, "limit", "", 5, "lists number of taskruns")
 
-	_ = c.MarkZshCompPositionalArgumentCustom(1, "__tkn_get_task")
+	completion.RegisterArgs(c, 1, "task")
 	return c
 }
 
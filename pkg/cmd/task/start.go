@@ -25,6 +25,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
 	"github.com/tektoncd/cli/pkg/cmd/taskrun"
 	"github.com/tektoncd/cli/pkg/flags"
 	"github.com/tektoncd/cli/pkg/helper/labels"
@@ -85,6 +86,12 @@ func NameArg(args []string, p cli.Params) error {
 	return nil
 }
 
+func init() {
+	completion.Define("task-params", completion.KeyValue(completeTaskParams, nil))
+	completion.Define("task-inputresources", completion.KeyValue(completeTaskResources(inputResources), completeTaskResourceRefs(inputResources)))
+	completion.Define("task-outputresources", completion.KeyValue(completeTaskResources(outputResources), completeTaskResourceRefs(outputResources)))
+}
+
 func startCommand(p cli.Params) *cobra.Command {
 	opt := startOptions{
 		cliparams: p,
@@ -134,14 +141,17 @@ like cat,foo,bar
 	c.Flags().StringSliceVarP(&opt.OutputResources, "outputresource", "o", []string{}, "pass the output resource name and ref as name=ref")
 	c.Flags().StringArrayVarP(&opt.Params, "param", "p", []string{}, "pass the param as key=value or key=value1,value2")
 	c.Flags().StringVarP(&opt.ServiceAccountName, "serviceaccount", "s", "", "pass the serviceaccount name")
-	flags.AddShellCompletion(c.Flags().Lookup("serviceaccount"), "__kubectl_get_serviceaccount")
 	c.Flags().BoolVarP(&opt.Last, "last", "L", false, "re-run the task using last taskrun values")
 	c.Flags().StringSliceVarP(&opt.Labels, "labels", "l", []string{}, "pass labels as label=value.")
 	c.Flags().BoolVarP(&opt.ShowLog, "showlog", "", true, "show logs right after starting the task")
 	c.Flags().StringVarP(&opt.Filename, "filename", "f", "", "filename containing a task definition")
 	c.Flags().Int64VarP(&opt.TimeOut, "timeout", "t", 3600, "timeout for taskrun in seconds")
 
-	_ = c.MarkZshCompPositionalArgumentCustom(1, "__tkn_get_task")
+	completion.RegisterArgs(c, 1, "task")
+	completion.RegisterFlag(c, "param", "task-params")
+	completion.RegisterFlag(c, "inputresource", "task-inputresources")
+	completion.RegisterFlag(c, "outputresource", "task-outputresources")
+	completion.RegisterFlag(c, "serviceaccount", "serviceaccount")
 
 	return c
 }
@@ -291,3 +301,75 @@ func parseRes(res []string) (map[string]v1alpha1.TaskResourceBinding, error) {
 	}
 	return resources, nil
 }
+
+// completedTask returns the task being started on the command line being
+// completed, or nil if it isn't there yet
+func completedTask(p cli.Params, args []string) (*v1alpha1.Task, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+
+	cs, err := p.Clients()
+	if err != nil {
+		return nil, err
+	}
+	return cs.Tekton.TektonV1alpha1().Tasks(p.Namespace()).Get(args[0], metav1.GetOptions{})
+}
+
+func completeTaskParams(p cli.Params, args []string) ([]string, error) {
+	task, err := completedTask(p, args)
+	if task == nil || task.Spec.Inputs == nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, param := range task.Spec.Inputs.Params {
+		names = append(names, param.Name)
+	}
+	return names, nil
+}
+
+func inputResources(task *v1alpha1.Task) []v1alpha1.TaskResource {
+	if task.Spec.Inputs == nil {
+		return nil
+	}
+	return task.Spec.Inputs.Resources
+}
+
+func outputResources(task *v1alpha1.Task) []v1alpha1.TaskResource {
+	if task.Spec.Outputs == nil {
+		return nil
+	}
+	return task.Spec.Outputs.Resources
+}
+
+func completeTaskResources(resources func(*v1alpha1.Task) []v1alpha1.TaskResource) func(cli.Params, []string) ([]string, error) {
+	return func(p cli.Params, args []string) ([]string, error) {
+		task, err := completedTask(p, args)
+		if task == nil {
+			return nil, err
+		}
+
+		names := []string{}
+		for _, res := range resources(task) {
+			names = append(names, res.Name)
+		}
+		return names, nil
+	}
+}
+
+func completeTaskResourceRefs(resources func(*v1alpha1.Task) []v1alpha1.TaskResource) func(cli.Params, []string, string) ([]string, error) {
+	return func(p cli.Params, args []string, key string) ([]string, error) {
+		task, err := completedTask(p, args)
+		if task == nil {
+			return nil, err
+		}
+
+		for _, res := range resources(task) {
+			if res.Name == key {
+				return completion.Resources(p, res.Type)
+			}
+		}
+		return nil, nil
+	}
+}
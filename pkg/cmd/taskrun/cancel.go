@@ -19,7 +19,10 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
 	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/cli/pkg/helper/options"
+	trhsort "github.com/tektoncd/cli/pkg/helper/taskrun/sort"
 	validate "github.com/tektoncd/cli/pkg/helper/validate"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -32,38 +35,93 @@ const (
 )
 
 func cancelCommand(p cli.Params) *cobra.Command {
+	opts := &options.CancelOptions{Resource: "taskrun", OwnerKind: "task", OwnerLabel: "tekton.dev/task"}
 	eg := `
 # Cancel the TaskRun named "foo" from the namespace "bar"
 tkn taskrun cancel foo -n bar
+
+# Cancel all the running TaskRuns of the Task "foo" without confirmation
+tkn taskrun cancel --task foo -n bar -f
+
+# Cancel the running TaskRuns of the PipelineRun "foo"
+tkn taskrun cancel -l tekton.dev/pipelineRun=foo -n bar
 `
 
 	c := &cobra.Command{
 		Use:          "cancel",
-		Short:        "Cancel a taskrun in a namespace",
+		Short:        "Cancel a taskrun in a namespace, or all the running ones matching the flags",
 		Example:      eg,
-		Args:         cobra.ExactArgs(1),
+		Args:         cobra.MaximumNArgs(1),
 		SilenceUsage: true,
 		Annotations: map[string]string{
 			"commandType": "main",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			s := &cli.Stream{
+				In:  cmd.InOrStdin(),
 				Out: cmd.OutOrStdout(),
 				Err: cmd.OutOrStderr(),
 			}
 
+			if err := opts.Validate(args); err != nil {
+				return err
+			}
+
 			if err := validate.NamespaceExists(p); err != nil {
 				return err
 			}
 
-			return cancelTaskRun(p, s, args[0])
+			if !opts.Bulk() {
+				return cancelTaskRun(p, s, args[0])
+			}
+
+			names, err := runningTaskRuns(p, opts)
+			if err != nil {
+				return err
+			}
+			if len(names) == 0 {
+				fmt.Fprintln(s.Out, "No running taskruns to cancel")
+				return nil
+			}
+
+			if err := opts.CheckOptions(s, names); err != nil {
+				return err
+			}
+
+			return opts.CancelEach(s, names, func(name string) error {
+				return cancelTaskRun(p, s, name)
+			})
 		},
 	}
+	opts.AddFlags(c)
 
-	_ = c.MarkZshCompPositionalArgumentCustom(1, "__tkn_get_taskrun")
+	completion.RegisterArgs(c, 1, "taskrun")
 	return c
 }
 
+// runningTaskRuns returns the names of the taskruns selected by the options
+// which are neither done nor already being cancelled, newest first
+func runningTaskRuns(p cli.Params, opts *options.CancelOptions) ([]string, error) {
+	cs, err := p.Clients()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tekton client")
+	}
+
+	trs, err := cs.Tekton.TektonV1alpha1().TaskRuns(p.Namespace()).List(opts.ListOptions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list taskruns from %s namespace: %s", p.Namespace(), err)
+	}
+
+	names := []string{}
+	runs := trhsort.SortTaskRunsByStartTime(trs.Items)
+	for i := range runs {
+		if tr := &runs[i]; !tr.IsDone() && !tr.IsCancelled() {
+			names = append(names, tr.Name)
+		}
+	}
+	return names, nil
+}
+
 func cancelTaskRun(p cli.Params, s *cli.Stream, trName string) error {
 	cs, err := p.Clients()
 	if err != nil {
@@ -16,7 +16,10 @@ package taskrun
 
 import (
 	"errors"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/tektoncd/cli/pkg/test"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
@@ -152,3 +155,126 @@ func TestTaskRunCancel(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskRunCancel_bulk(t *testing.T) {
+	now := time.Now()
+	running := func(name, task string, started time.Time) *v1alpha1.TaskRun {
+		return tb.TaskRun(name, "ns",
+			tb.TaskRunLabel("tekton.dev/task", task),
+			tb.TaskRunSpec(tb.TaskRunTaskRef(task)),
+			tb.TaskRunStatus(
+				tb.StatusCondition(apis.Condition{
+					Type:   apis.ConditionSucceeded,
+					Status: corev1.ConditionUnknown,
+					Reason: resources.ReasonRunning,
+				}),
+				tb.TaskRunStartTime(started),
+			),
+		)
+	}
+
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	seeds := make([]pipelinetest.Clients, 0)
+	for i := 0; i < 4; i++ {
+		cs, _ := test.SeedTestData(t, pipelinetest.Data{
+			TaskRuns: []*v1alpha1.TaskRun{
+				running("build-1", "build", now.Add(-2*time.Minute)),
+				running("build-2", "build", now.Add(-1*time.Minute)),
+				running("test-1", "test", now),
+				tb.TaskRun("build-0", "ns",
+					tb.TaskRunLabel("tekton.dev/task", "build"),
+					tb.TaskRunSpec(tb.TaskRunTaskRef("build")),
+					tb.TaskRunStatus(
+						tb.StatusCondition(apis.Condition{
+							Type:   apis.ConditionSucceeded,
+							Status: corev1.ConditionTrue,
+							Reason: resources.ReasonSucceeded,
+						}),
+					),
+				),
+			},
+			Namespaces: ns,
+		})
+		seeds = append(seeds, cs)
+	}
+
+	testParams := []struct {
+		name        string
+		command     []string
+		input       pipelinetest.Clients
+		inputStream io.Reader
+		wantError   bool
+		want        string
+	}{
+		{
+			name:      "No name nor selection",
+			command:   []string{"cancel", "-n", "ns"},
+			input:     seeds[0],
+			wantError: true,
+			want:      "Error: a taskrun name or one of --all-running, --selector or --task is required\n",
+		},
+		{
+			name:      "Name and selection",
+			command:   []string{"cancel", "build-1", "--task", "build", "-n", "ns"},
+			input:     seeds[0],
+			wantError: true,
+			want:      "Error: --all-running, --selector and --task can not be used with a taskrun name\n",
+		},
+		{
+			name:      "Cancel the running taskruns of a task",
+			command:   []string{"cancel", "--task", "build", "-n", "ns", "-f"},
+			input:     seeds[0],
+			wantError: false,
+			want:      "The following taskruns will be cancelled:\n  build-2\n  build-1\nTaskRun cancelled: build-2\nTaskRun cancelled: build-1\n",
+		},
+		{
+			name:        "Cancel all the running taskruns, reply yes",
+			command:     []string{"cancel", "--all-running", "-n", "ns"},
+			input:       seeds[1],
+			inputStream: strings.NewReader("y"),
+			wantError:   false,
+			want:        "The following taskruns will be cancelled:\n  test-1\n  build-2\n  build-1\nAre you sure you want to cancel 3 taskrun(s) (y/n): TaskRun cancelled: test-1\nTaskRun cancelled: build-2\nTaskRun cancelled: build-1\n",
+		},
+		{
+			name:        "Cancel all the running taskruns, reply no",
+			command:     []string{"cancel", "--all-running", "-n", "ns"},
+			input:       seeds[2],
+			inputStream: strings.NewReader("n"),
+			wantError:   true,
+			want:        "The following taskruns will be cancelled:\n  test-1\n  build-2\n  build-1\nAre you sure you want to cancel 3 taskrun(s) (y/n): Error: canceled cancelling taskruns\n",
+		},
+		{
+			name:      "No running taskrun matching the selector",
+			command:   []string{"cancel", "-l", "tekton.dev/task=deploy", "-n", "ns"},
+			input:     seeds[3],
+			wantError: false,
+			want:      "No running taskruns to cancel\n",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			p := &test.Params{Tekton: tp.input.Pipeline, Kube: tp.input.Kube}
+			taskrun := Command(p)
+			if tp.inputStream != nil {
+				taskrun.SetIn(tp.inputStream)
+			}
+
+			out, err := test.ExecuteCommand(taskrun, tp.command...)
+			if tp.wantError && err == nil {
+				t.Errorf("error expected here")
+			}
+			if !tp.wantError && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			test.AssertOutput(t, tp.want, out)
+		})
+	}
+}
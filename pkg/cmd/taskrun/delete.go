@@ -19,6 +19,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
 	"github.com/tektoncd/cli/pkg/helper/options"
 	validate "github.com/tektoncd/cli/pkg/helper/validate"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -27,20 +28,32 @@ import (
 
 func deleteCommand(p cli.Params) *cobra.Command {
 	opts := &options.DeleteOptions{Resource: "taskrun", ForceDelete: false}
+	runOpts := &options.RunDeleteOptions{}
 	f := cliopts.NewPrintFlags("delete")
 	eg := `
 # Delete a TaskRun of name 'foo' in namespace 'bar'
 tkn taskrun delete foo -n bar
 
 tkn tr rm foo -n bar
+
+# Delete the TaskRuns 'foo' and 'baz' but keep their pods
+tkn tr rm foo baz -n bar --cascade orphan
+
+# Delete the TaskRuns of namespace 'bar' but the newest 5 of each Task
+tkn tr rm --keep 5 -n bar
+
+# Show the failed TaskRuns older than a week which would be deleted
+tkn tr rm --status failed --older-than 7d -n bar --dry-run
+
+# Delete all the TaskRuns of Task 'foo' without being asked
+tkn tr rm --all -l tekton.dev/task=foo -n bar -f
 `
 
 	c := &cobra.Command{
 		Use:          "delete",
 		Aliases:      []string{"rm"},
-		Short:        "Delete a taskrun in a namespace",
+		Short:        "Delete taskruns in a namespace",
 		Example:      eg,
-		Args:         cobra.MinimumNArgs(1),
 		SilenceUsage: true,
 		Annotations: map[string]string{
 			"commandType": "main",
@@ -52,32 +65,97 @@ tkn tr rm foo -n bar
 				Err: cmd.OutOrStderr(),
 			}
 
+			if err := runOpts.Validate("taskrun", args); err != nil {
+				return err
+			}
+
+			if err := opts.ValidateCascade(); err != nil {
+				return err
+			}
+
 			if err := validate.NamespaceExists(p); err != nil {
 				return err
 			}
 
-			if err := opts.CheckOptions(s, args[0]); err != nil {
+			names := args
+			if runOpts.Bulk() {
+				var err error
+				if names, err = selectTaskRuns(p, runOpts); err != nil {
+					return err
+				}
+				if len(names) == 0 {
+					fmt.Fprintln(s.Out, "No taskruns to delete")
+					return nil
+				}
+			}
+
+			if runOpts.Bulk() || opts.DryRun {
+				if err := opts.CheckBulkOptions(s, names); err != nil {
+					return err
+				}
+			} else if err := opts.CheckOptions(s, names...); err != nil {
 				return err
 			}
+			if opts.DryRun {
+				return nil
+			}
 
-			return deleteTaskRun(s, p, args[0])
+			return opts.DeleteEach(s, names, func(name string) error {
+				return deleteTaskRun(opts, s, p, name)
+			})
 		},
 	}
 	f.AddFlags(c)
+	runOpts.AddFlags(c)
 	c.Flags().BoolVarP(&opts.ForceDelete, "force", "f", false, "Whether to force deletion (default: false)")
-	_ = c.MarkZshCompPositionalArgumentCustom(1, "__tkn_get_taskrun")
+	opts.AddCascadeFlags(c)
+	c.Flags().BoolVarP(&opts.DryRun, "dry-run", "", false, "only print the taskruns which would be deleted")
+	completion.RegisterArgs(c, 0, "taskrun")
 	return c
 }
 
-func deleteTaskRun(s *cli.Stream, p cli.Params, trName string) error {
+// selectTaskRuns returns the names of the taskruns of the namespace
+// to delete in bulk
+func selectTaskRuns(p cli.Params, opts *options.RunDeleteOptions) ([]string, error) {
+	cs, err := p.Clients()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tekton client")
+	}
+
+	trs, err := cs.Tekton.TektonV1alpha1().TaskRuns(p.Namespace()).List(metav1.ListOptions{
+		LabelSelector: opts.Selector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list taskruns from namespace %s: %s", p.Namespace(), err)
+	}
+
+	runs := []options.Run{}
+	for _, tr := range trs.Items {
+		runs = append(runs, options.Run{
+			Name:       tr.Name,
+			Group:      tr.Labels["tekton.dev/task"],
+			Conditions: tr.Status.Conditions,
+			Created:    tr.CreationTimestamp.Time,
+		})
+	}
+	return opts.Select(runs, p.Time().Now()), nil
+}
+
+func deleteTaskRun(opts *options.DeleteOptions, s *cli.Stream, p cli.Params, trName string) error {
 	cs, err := p.Clients()
 	if err != nil {
 		return fmt.Errorf("failed to create tekton client")
 	}
 
-	if err := cs.Tekton.TektonV1alpha1().TaskRuns(p.Namespace()).Delete(trName, &metav1.DeleteOptions{}); err != nil {
+	if err := cs.Tekton.TektonV1alpha1().TaskRuns(p.Namespace()).Delete(trName, opts.KubeDeleteOptions()); err != nil {
 		return fmt.Errorf("failed to delete taskrun %q: %s", trName, err)
 	}
+	if err := opts.WaitDeleted(func() error {
+		_, err := cs.Tekton.TektonV1alpha1().TaskRuns(p.Namespace()).Get(trName, metav1.GetOptions{})
+		return err
+	}); err != nil {
+		return fmt.Errorf("failed to wait for taskrun %q to be deleted: %s", trName, err)
+	}
 
 	fmt.Fprintf(s.Out, "TaskRun deleted: %s\n", trName)
 	return nil
@@ -18,8 +18,11 @@ import (
 	"io"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/jonboulle/clockwork"
 	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
 	"github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/resources"
 	pipelinetest "github.com/tektoncd/pipeline/test"
@@ -138,3 +141,147 @@ func TestTaskRunDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskRunDelete_bulk(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	taskrun := func(name, task string, age time.Duration) *v1alpha1.TaskRun {
+		return tb.TaskRun(name, "ns",
+			cb.TaskRunCreationTime(clock.Now().Add(-age)),
+			tb.TaskRunLabel("tekton.dev/task", task),
+			tb.TaskRunStatus(
+				tb.StatusCondition(apis.Condition{
+					Status: corev1.ConditionTrue,
+					Reason: resources.ReasonSucceeded,
+				}),
+			),
+		)
+	}
+	trs := []*v1alpha1.TaskRun{
+		taskrun("lint-1", "lint", 3*time.Hour),
+		taskrun("lint-2", "lint", 2*time.Hour),
+		taskrun("lint-3", "lint", time.Hour),
+		taskrun("test-1", "test", 3*24*time.Hour),
+	}
+
+	testParams := []struct {
+		name      string
+		command   []string
+		want      string
+		remaining []string
+	}{
+		{
+			name:      "Keep the newest 2 of each task",
+			command:   []string{"rm", "-n", "ns", "--keep", "2", "-f"},
+			want:      "The following taskruns will be deleted:\n  lint-1\nTaskRun deleted: lint-1\n",
+			remaining: []string{"lint-2", "lint-3", "test-1"},
+		},
+		{
+			name:      "Older than a day, dry run",
+			command:   []string{"rm", "-n", "ns", "--older-than", "1d", "--dry-run"},
+			want:      "The following taskruns would be deleted:\n  test-1\n",
+			remaining: []string{"lint-1", "lint-2", "lint-3", "test-1"},
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			cs, _ := test.SeedTestData(t, pipelinetest.Data{TaskRuns: trs, Namespaces: ns})
+			p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Clock: clock}
+
+			out, err := test.ExecuteCommand(Command(p), tp.command...)
+			if err != nil {
+				t.Errorf("Unexpected Error: %v", err)
+			}
+			test.AssertOutput(t, tp.want, out)
+
+			list, err := cs.Pipeline.TektonV1alpha1().TaskRuns("ns").List(metav1.ListOptions{})
+			if err != nil {
+				t.Fatalf("Unexpected Error: %v", err)
+			}
+			remaining := []string{}
+			for _, tr := range list.Items {
+				remaining = append(remaining, tr.Name)
+			}
+			test.AssertOutput(t, tp.remaining, remaining)
+		})
+	}
+}
+
+func TestTaskRunDelete_names(t *testing.T) {
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+	trs := []*v1alpha1.TaskRun{
+		tb.TaskRun("tr-1", "ns", tb.TaskRunLabel("tekton.dev/task", "task")),
+		tb.TaskRun("tr-2", "ns", tb.TaskRunLabel("tekton.dev/task", "task")),
+	}
+
+	testParams := []struct {
+		name        string
+		command     []string
+		inputStream io.Reader
+		wantError   string
+		want        string
+	}{
+		{
+			name:        "Several names, reply yes",
+			command:     []string{"rm", "tr-1", "tr-2", "-n", "ns"},
+			inputStream: strings.NewReader("y"),
+			want:        "Are you sure you want to delete taskruns \"tr-1\", \"tr-2\" (y/n): TaskRun deleted: tr-1\nTaskRun deleted: tr-2\n",
+		},
+		{
+			name:      "Several names, one missing",
+			command:   []string{"rm", "tr-1", "missing", "tr-2", "-n", "ns", "-f", "--cascade", "orphan"},
+			wantError: "failed to delete 1 of 3 taskruns",
+			want:      "TaskRun deleted: tr-1\nError: failed to delete taskrun \"missing\": taskruns.tekton.dev \"missing\" not found\nTaskRun deleted: tr-2\nError: failed to delete 1 of 3 taskruns\n",
+		},
+		{
+			name:    "Wait",
+			command: []string{"rm", "tr-1", "-n", "ns", "-f", "--wait"},
+			want:    "TaskRun deleted: tr-1\n",
+		},
+		{
+			name:      "Invalid cascade",
+			command:   []string{"rm", "tr-1", "-n", "ns", "-f", "--cascade", "all"},
+			wantError: "invalid cascade \"all\", must be one of: foreground|background|orphan",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			cs, _ := test.SeedTestData(t, pipelinetest.Data{TaskRuns: trs, Namespaces: ns})
+			p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+			taskrun := Command(p)
+			if tp.inputStream != nil {
+				taskrun.SetIn(tp.inputStream)
+			}
+
+			out, err := test.ExecuteCommand(taskrun, tp.command...)
+			if tp.wantError != "" {
+				if err == nil {
+					t.Fatalf("Error expected here")
+				}
+				test.AssertOutput(t, tp.wantError, err.Error())
+				if tp.want == "" {
+					return
+				}
+			} else if err != nil {
+				t.Errorf("Unexpected Error: %v", err)
+			}
+			test.AssertOutput(t, tp.want, out)
+		})
+	}
+}
@@ -16,17 +16,24 @@ package taskrun
 
 import (
 	"fmt"
+	"io"
+	"os"
+	"strings"
 
 	"text/tabwriter"
 	"text/template"
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
 	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/cli/pkg/helper/pods"
 	validate "github.com/tektoncd/cli/pkg/helper/validate"
+	"github.com/tektoncd/cli/pkg/printer"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	cliopts "k8s.io/cli-runtime/pkg/genericclioptions"
 )
 
@@ -92,13 +99,74 @@ NAME	VALUE
 {{- end }}
 
 Steps
-{{- $l := len .TaskRun.Status.Steps }}{{ if eq $l 0 }}
+{{- $l := len .Steps }}{{ if eq $l 0 }}
 No steps
 {{- else }}
-NAME	STATUS
-{{- range $step := .TaskRun.Status.Steps }}
-{{- $reason := stepReasonExists $step }}
-{{ $step.Name }}	{{ $reason }}
+NAME	STATUS	EXIT CODE	STARTED	FINISHED	DURATION
+{{- range $step := .Steps }}
+{{ $step.Name }}	{{ $step.Status }}	{{ $step.ExitCode }}	{{ formatAge $step.StartedAt $.Params.Time }}	{{ formatAge $step.FinishedAt $.Params.Time }}	{{ formatDuration $step.StartedAt $step.FinishedAt }}
+{{- end }}
+{{- end }}
+{{- if hasImages .Steps }}
+
+Step Images
+NAME	IMAGE	DIGEST
+{{- range $step := .Steps }}
+{{ $step.Name }}	{{ $step.Image }}	{{ $step.Digest }}
+{{- end }}
+{{- end }}
+{{- if hasMessages .Steps }}
+
+Step Messages
+NAME	MESSAGE
+{{- range $step := .Steps }}{{ if ne $step.Message "" }}
+{{ $step.Name }}	{{ $step.Message }}
+{{- end }}{{ end }}
+{{- end }}
+{{- $l := len .Sidecars }}{{ if ne $l 0 }}
+
+Sidecars
+NAME	IMAGE	DIGEST
+{{- range $sidecar := .Sidecars }}
+{{ $sidecar.Name }}	{{ $sidecar.Image }}	{{ $sidecar.Digest }}
+{{- end }}
+{{- end }}
+{{- $l := len .TaskRun.Status.ResourcesResult }}{{ if ne $l 0 }}
+
+Resource Results
+RESOURCE	KEY	VALUE
+{{- range $r := .TaskRun.Status.ResourcesResult }}
+{{- if ne $r.Key "" }}
+{{ $r.ResourceRef.Name }}	{{ $r.Key }}	{{ $r.Value }}
+{{- else }}
+{{ $r.Name }}	digest	{{ $r.Digest }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- if .Pod }}
+{{- $l := len .Pod.Conditions }}{{ if ne $l 0 }}
+
+Pod Conditions
+TYPE	STATUS	REASON	MESSAGE
+{{- range $c := .Pod.Conditions }}
+{{ $c.Type }}	{{ $c.Status }}	{{ $c.Reason }}	{{ $c.Message }}
+{{- end }}
+{{- end }}
+{{- $l := len .Pod.Waiting }}{{ if ne $l 0 }}
+
+Waiting Containers
+NAME	REASON	MESSAGE
+{{- range $w := .Pod.Waiting }}
+{{ $w.Container }}	{{ $w.Reason }}	{{ $w.Message }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- $l := len .Events }}{{ if ne $l 0 }}
+
+Events
+LAST SEEN	TYPE	OBJECT	REASON	MESSAGE
+{{- range $e := .Events }}
+{{ formatAge $e.LastSeen $.Params.Time }}	{{ $e.Type }}	{{ $e.Object }}	{{ $e.Reason }}	{{ $e.Message }}
 {{- end }}
 {{- end }}
 `
@@ -132,17 +200,49 @@ tkn tr desc foo -n bar
 				return err
 			}
 
-			return printTaskRunDescription(s, args[0], p)
+			output, err := cmd.LocalFlags().GetString("output")
+			if err != nil {
+				fmt.Fprint(os.Stderr, "Error: output option not set properly \n")
+				return err
+			}
+
+			if output != "" {
+				return printTaskRunObj(s.Out, args[0], p, f)
+			}
+
+			return printTaskRunDescription(s, args[0], p, f)
 		},
 	}
 
-	_ = c.MarkZshCompPositionalArgumentCustom(1, "__tkn_get_taskrun")
+	completion.RegisterArgs(c, 1, "taskrun")
 	f.AddFlags(c)
 
 	return c
 }
 
-func printTaskRunDescription(s *cli.Stream, trName string, p cli.Params) error {
+func printTaskRunObj(w io.Writer, trName string, p cli.Params, f *cliopts.PrintFlags) error {
+	cs, err := p.Clients()
+	if err != nil {
+		return fmt.Errorf("failed to create tekton client")
+	}
+
+	tr, err := cs.Tekton.TektonV1alpha1().TaskRuns(p.Namespace()).Get(trName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to find taskrun %q", trName)
+	}
+
+	// NOTE: this is required for -o json|yaml to work properly since
+	// tektoncd go client fails to set these; probably a bug
+	tr.GetObjectKind().SetGroupVersionKind(
+		schema.GroupVersionKind{
+			Version: "tekton.dev/v1alpha1",
+			Kind:    "TaskRun",
+		})
+
+	return printer.PrintObject(w, tr, f)
+}
+
+func printTaskRunDescription(s *cli.Stream, trName string, p cli.Params, f *cliopts.PrintFlags) error {
 	cs, err := p.Clients()
 	if err != nil {
 		return fmt.Errorf("failed to create tekton client")
@@ -153,12 +253,27 @@ func printTaskRunDescription(s *cli.Stream, trName string, p cli.Params) error {
 		return fmt.Errorf("failed to find taskrun %q", trName)
 	}
 
+	spec := taskSpec(cs, tr)
+
+	events, err := taskRunEvents(cs, tr)
+	if err != nil {
+		fmt.Fprintf(s.Err, "Failed to list the events of taskrun %s: %v\n", tr.Name, err)
+	}
+
 	var data = struct {
-		TaskRun *v1alpha1.TaskRun
-		Params  cli.Params
+		TaskRun  *v1alpha1.TaskRun
+		Params   cli.Params
+		Steps    []containerDetail
+		Sidecars []containerDetail
+		Pod      *pods.Diagnostics
+		Events   []pods.Event
 	}{
-		TaskRun: tr,
-		Params:  p,
+		TaskRun:  tr,
+		Params:   p,
+		Steps:    stepDetails(tr, spec),
+		Sidecars: sidecarDetails(tr, spec),
+		Pod:      podDiagnostics(cs, tr),
+		Events:   events,
 	}
 
 	funcMap := template.FuncMap{
@@ -169,10 +284,21 @@ func printTaskRunDescription(s *cli.Stream, trName string, p cli.Params) error {
 		"taskRefExists":         validate.TaskRefExists,
 		"taskResourceRefExists": validate.TaskResourceRefExists,
 		"stepReasonExists":      validate.StepReasonExists,
+		"hasImages":             hasImages,
+		"hasMessages":           hasMessages,
+	}
+
+	tmpl, err := printer.DescribeTemplate(f, templ)
+	if err != nil {
+		return err
+	}
+
+	t, err := template.New("Describe taskrun").Funcs(funcMap).Parse(tmpl)
+	if err != nil {
+		return err
 	}
 
 	w := tabwriter.NewWriter(s.Out, 0, 5, 3, ' ', tabwriter.TabIndent)
-	t := template.Must(template.New("Describe taskrun").Funcs(funcMap).Parse(templ))
 
 	err = t.Execute(w, data)
 	if err != nil {
@@ -193,3 +319,147 @@ func hasFailed(tr *v1alpha1.TaskRun) string {
 
 	return ""
 }
+
+// containerDetail is the state of a step or sidecar of a taskrun, along with
+// the image it runs as declared in the task
+type containerDetail struct {
+	Name       string
+	Status     string
+	ExitCode   string
+	StartedAt  *metav1.Time
+	FinishedAt *metav1.Time
+	Image      string
+	Digest     string
+	Message    string
+}
+
+// taskSpec returns the spec of the task run by the taskrun, nil if it can
+// not be found
+func taskSpec(cs *cli.Clients, tr *v1alpha1.TaskRun) *v1alpha1.TaskSpec {
+	if tr.Spec.TaskSpec != nil {
+		return tr.Spec.TaskSpec
+	}
+
+	if tr.Spec.TaskRef == nil {
+		return nil
+	}
+
+	if tr.Spec.TaskRef.Kind == v1alpha1.ClusterTaskKind {
+		ct, err := cs.Tekton.TektonV1alpha1().ClusterTasks().Get(tr.Spec.TaskRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil
+		}
+		return &ct.Spec
+	}
+
+	t, err := cs.Tekton.TektonV1alpha1().Tasks(tr.Namespace).Get(tr.Spec.TaskRef.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil
+	}
+	return &t.Spec
+}
+
+func stepDetails(tr *v1alpha1.TaskRun, spec *v1alpha1.TaskSpec) []containerDetail {
+	images := map[string]string{}
+	if spec != nil {
+		for _, s := range spec.Steps {
+			images[s.Name] = s.Image
+		}
+	}
+
+	steps := []containerDetail{}
+	for _, state := range tr.Status.Steps {
+		step := containerDetail{
+			Name:     state.Name,
+			Status:   validate.StepReasonExists(state),
+			ExitCode: "---",
+			Image:    images[state.Name],
+			Digest:   imageDigest(state.ImageID),
+		}
+
+		switch {
+		case state.Running != nil:
+			step.StartedAt = &state.Running.StartedAt
+		case state.Terminated != nil:
+			step.ExitCode = fmt.Sprintf("%d", state.Terminated.ExitCode)
+			step.StartedAt = &state.Terminated.StartedAt
+			step.FinishedAt = &state.Terminated.FinishedAt
+			step.Message = strings.TrimSpace(state.Terminated.Message)
+		}
+
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+func sidecarDetails(tr *v1alpha1.TaskRun, spec *v1alpha1.TaskSpec) []containerDetail {
+	images := map[string]string{}
+	if spec != nil {
+		for _, s := range spec.Sidecars {
+			images[s.Name] = s.Image
+		}
+	}
+
+	sidecars := []containerDetail{}
+	for _, state := range tr.Status.Sidecars {
+		sidecars = append(sidecars, containerDetail{
+			Name:   state.Name,
+			Image:  images[state.Name],
+			Digest: imageDigest(state.ImageID),
+		})
+	}
+	return sidecars
+}
+
+// imageDigest extracts the digest from an image ID as reported by the
+// kubelet, e.g. docker-pullable://busybox@sha256:abc...
+func imageDigest(imageID string) string {
+	if i := strings.LastIndex(imageID, "@"); i >= 0 {
+		return imageID[i+1:]
+	}
+	return imageID
+}
+
+func hasImages(details []containerDetail) bool {
+	for _, d := range details {
+		if d.Image != "" || d.Digest != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func hasMessages(details []containerDetail) bool {
+	for _, d := range details {
+		if d.Message != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// podDiagnostics returns why the pod of a taskrun which is not done yet is
+// not running, nil if the taskrun is done or its pod can not be found
+func podDiagnostics(cs *cli.Clients, tr *v1alpha1.TaskRun) *pods.Diagnostics {
+	if tr.IsDone() || tr.Status.PodName == "" {
+		return nil
+	}
+
+	pod, err := cs.Kube.CoreV1().Pods(tr.Namespace).Get(tr.Status.PodName, metav1.GetOptions{})
+	if err != nil {
+		return nil
+	}
+
+	d := pods.Diagnose(pod)
+	return &d
+}
+
+// taskRunEvents returns the events about the taskrun and its pod
+func taskRunEvents(cs *cli.Clients, tr *v1alpha1.TaskRun) ([]pods.Event, error) {
+	refs := []corev1.ObjectReference{{Kind: "TaskRun", Name: tr.Name}}
+	if tr.Status.PodName != "" {
+		refs = append(refs, corev1.ObjectReference{Kind: "Pod", Name: tr.Status.PodName})
+	}
+
+	return pods.Events(cs.Kube, tr.Namespace, refs...)
+}
@@ -15,6 +15,8 @@
 package taskrun
 
 import (
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -27,6 +29,8 @@ import (
 	tb "github.com/tektoncd/pipeline/test/builder"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stest "k8s.io/client-go/testing"
 	"knative.dev/pkg/apis"
 )
 
@@ -238,9 +242,9 @@ input    param
 input2   param2
 
 Steps
-NAME    STATUS
-step1   Completed
-step2   Completed
+NAME    STATUS      EXIT CODE   STARTED   FINISHED   DURATION
+step1   Completed   0           ---       ---        ---
+step2   Completed   0           ---       ---        ---
 `
 
 	test.AssertOutput(t, expected, actual)
@@ -451,9 +455,9 @@ input    param
 input2   param2
 
 Steps
-NAME    STATUS
-step1   Completed
-step2   Completed
+NAME    STATUS      EXIT CODE   STARTED   FINISHED   DURATION
+step1   Completed   0           ---       ---        ---
+step2   Completed   0           ---       ---        ---
 `
 
 	test.AssertOutput(t, expected, actual)
@@ -535,9 +539,9 @@ input    param
 input2   param2
 
 Steps
-NAME    STATUS
-step1   Error
-step2   ---
+NAME    STATUS   EXIT CODE   STARTED   FINISHED   DURATION
+step1   Error    0           ---       ---        ---
+step2   ---      ---         ---       ---        ---
 `
 
 	test.AssertOutput(t, expected, actual)
@@ -621,9 +625,9 @@ input    param
 input2   param2
 
 Steps
-NAME    STATUS
-step1   PodInitializing
-step2   PodInitializing
+NAME    STATUS            EXIT CODE   STARTED   FINISHED   DURATION
+step1   PodInitializing   ---         ---       ---        ---
+step2   PodInitializing   ---         ---       ---        ---
 `
 
 	test.AssertOutput(t, expected, actual)
@@ -632,7 +636,7 @@ step2   PodInitializing
 func TestTaskRunDescribe_step_status_running(t *testing.T) {
 	clock := clockwork.NewFakeClock()
 
-	reasonRunning := setStepStateRunning(metav1.Time{Time: time.Now()})
+	reasonRunning := setStepStateRunning(metav1.Time{Time: clock.Now()})
 
 	trs := []*v1alpha1.TaskRun{
 		tb.TaskRun("tr-1", "ns",
@@ -707,10 +711,367 @@ input    param
 input2   param2
 
 Steps
-NAME    STATUS
-step1   Running
-step2   Running
+NAME    STATUS    EXIT CODE   STARTED          FINISHED   DURATION
+step1   Running   ---         10 minutes ago   ---        ---
+step2   Running   ---         10 minutes ago   ---        ---
 `
 
 	test.AssertOutput(t, expected, actual)
 }
+
+func TestTaskRunDescribe_output(t *testing.T) {
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		TaskRuns: []*v1alpha1.TaskRun{
+			tb.TaskRun("tr-1", "ns",
+				tb.TaskRunSpec(
+					tb.TaskRunTaskRef("t1"),
+				),
+			),
+		},
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ns",
+				},
+			},
+		},
+	})
+
+	testParams := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "jsonpath output",
+			args:     []string{"desc", "tr-1", "-n", "ns", "-o", "jsonpath={.kind}/{.metadata.name}/{.spec.taskRef.name}"},
+			expected: "TaskRun/tr-1/t1",
+		},
+		{
+			name:     "custom text template",
+			args:     []string{"desc", "tr-1", "-n", "ns", "--template", "{{ .TaskRun.Name }}\t{{ taskRefExists .TaskRun.Spec }}\n"},
+			expected: "tr-1   t1\n",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+			taskrun := Command(p)
+			got, err := test.ExecuteCommand(taskrun, tp.args...)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			test.AssertOutput(t, tp.expected, got)
+		})
+	}
+}
+
+func TestTaskRunDescribe_step_details(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	tasks := []*v1alpha1.Task{
+		tb.Task("t1", "ns",
+			tb.TaskSpec(
+				tb.Step("build", "golang:1.13"),
+				tb.Step("push", "gcr.io/kaniko-project/executor"),
+				tb.Sidecar("registry", "registry:2"),
+			),
+		),
+	}
+
+	trs := []*v1alpha1.TaskRun{
+		tb.TaskRun("tr-1", "ns",
+			tb.TaskRunStatus(
+				tb.TaskRunStartTime(clock.Now()),
+				tb.StatusCondition(apis.Condition{
+					Type:   apis.ConditionSucceeded,
+					Status: corev1.ConditionFalse,
+					Reason: resources.ReasonFailed,
+				}),
+				tb.StepState(
+					cb.StepName("build"),
+					tb.SetStepStateTerminated(corev1.ContainerStateTerminated{
+						Reason:     "Completed",
+						StartedAt:  metav1.NewTime(clock.Now().Add(1 * time.Minute)),
+						FinishedAt: metav1.NewTime(clock.Now().Add(3 * time.Minute)),
+					}),
+				),
+				tb.StepState(
+					cb.StepName("push"),
+					tb.SetStepStateTerminated(corev1.ContainerStateTerminated{
+						Reason:     "Error",
+						ExitCode:   1,
+						Message:    "UNAUTHORIZED: authentication required\n",
+						StartedAt:  metav1.NewTime(clock.Now().Add(3 * time.Minute)),
+						FinishedAt: metav1.NewTime(clock.Now().Add(4 * time.Minute)),
+					}),
+				),
+			),
+			tb.TaskRunSpec(
+				tb.TaskRunTaskRef("t1"),
+			),
+		),
+	}
+	trs[0].Status.Steps[0].ImageID = "docker-pullable://golang@sha256:d9ed0a"
+	trs[0].Status.Steps[1].ImageID = "docker-pullable://gcr.io/kaniko-project/executor@sha256:78d44e"
+	trs[0].Status.Sidecars = []v1alpha1.SidecarState{
+		{Name: "registry", ImageID: "docker-pullable://registry@sha256:8be26f"},
+	}
+	trs[0].Status.ResourcesResult = []v1alpha1.PipelineResourceResult{
+		{
+			Key:         "commit",
+			Value:       "6c2a5a3",
+			ResourceRef: v1alpha1.PipelineResourceRef{Name: "git"},
+		},
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		Tasks:    tasks,
+		TaskRuns: trs,
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ns",
+				},
+			},
+		},
+	})
+
+	p := &test.Params{Tekton: cs.Pipeline, Clock: clock, Kube: cs.Kube}
+
+	taskrun := Command(p)
+	clock.Advance(10 * time.Minute)
+	actual, err := test.ExecuteCommand(taskrun, "desc", "tr-1", "-n", "ns")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	expected := `Name:        tr-1
+Namespace:   ns
+Task Ref:    t1
+
+Status
+STARTED          DURATION    STATUS
+10 minutes ago   ---         Failed
+
+Input Resources
+No resources
+
+Output Resources
+No resources
+
+Params
+No params
+
+Steps
+NAME    STATUS      EXIT CODE   STARTED         FINISHED        DURATION
+build   Completed   0           9 minutes ago   7 minutes ago   2 minutes
+push    Error       1           7 minutes ago   6 minutes ago   1 minute
+
+Step Images
+NAME    IMAGE                            DIGEST
+build   golang:1.13                      sha256:d9ed0a
+push    gcr.io/kaniko-project/executor   sha256:78d44e
+
+Step Messages
+NAME   MESSAGE
+push   UNAUTHORIZED: authentication required
+
+Sidecars
+NAME       IMAGE        DIGEST
+registry   registry:2   sha256:8be26f
+
+Resource Results
+RESOURCE   KEY      VALUE
+git        commit   6c2a5a3
+`
+
+	test.AssertOutput(t, expected, actual)
+}
+
+func TestTaskRunDescribe_pending_pod(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	trs := []*v1alpha1.TaskRun{
+		tb.TaskRun("tr-1", "ns",
+			tb.TaskRunStatus(
+				tb.TaskRunStartTime(clock.Now()),
+				tb.PodName("tr-1-pod"),
+				tb.StatusCondition(apis.Condition{
+					Type:   apis.ConditionSucceeded,
+					Status: corev1.ConditionUnknown,
+					Reason: "Pending",
+				}),
+			),
+			tb.TaskRunSpec(
+				tb.TaskRunTaskRef("t1"),
+			),
+		),
+	}
+
+	pods := []*corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tr-1-pod",
+				Namespace: "ns",
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodPending,
+				Conditions: []corev1.PodCondition{
+					{
+						Type:   corev1.PodScheduled,
+						Status: corev1.ConditionTrue,
+					},
+					{
+						Type:    corev1.PodReady,
+						Status:  corev1.ConditionFalse,
+						Reason:  "ContainersNotReady",
+						Message: "containers with unready status: [step-build]",
+					},
+				},
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name: "step-build",
+						State: corev1.ContainerState{
+							Waiting: &corev1.ContainerStateWaiting{
+								Reason:  "ImagePullBackOff",
+								Message: "Back-off pulling image \"busybox:nope\"",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		TaskRuns: trs,
+		Pods:     pods,
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ns",
+				},
+			},
+		},
+	})
+
+	events := []*corev1.Event{
+		{
+			ObjectMeta:     metav1.ObjectMeta{Name: "e1", Namespace: "ns"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "tr-1-pod"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "Failed",
+			Message:        "Failed to pull image \"busybox:nope\"",
+			LastTimestamp:  metav1.Time{Time: clock.Now().Add(2 * time.Minute)},
+		},
+		{
+			ObjectMeta:     metav1.ObjectMeta{Name: "e2", Namespace: "ns"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "tr-1-pod"},
+			Type:           corev1.EventTypeNormal,
+			Reason:         "Scheduled",
+			Message:        "Successfully assigned ns/tr-1-pod to node-1",
+			LastTimestamp:  metav1.Time{Time: clock.Now().Add(1 * time.Minute)},
+		},
+		{
+			ObjectMeta:     metav1.ObjectMeta{Name: "e3", Namespace: "ns"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "other-pod"},
+			Type:           corev1.EventTypeNormal,
+			Reason:         "Scheduled",
+			Message:        "Successfully assigned ns/other-pod to node-1",
+			LastTimestamp:  metav1.Time{Time: clock.Now().Add(1 * time.Minute)},
+		},
+	}
+	for _, e := range events {
+		if _, err := cs.Kube.CoreV1().Events("ns").Create(e); err != nil {
+			t.Fatalf("failed to create event: %v", err)
+		}
+	}
+
+	p := &test.Params{Tekton: cs.Pipeline, Clock: clock, Kube: cs.Kube}
+
+	taskrun := Command(p)
+	clock.Advance(10 * time.Minute)
+	actual, err := test.ExecuteCommand(taskrun, "desc", "tr-1", "-n", "ns")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	expected := `Name:        tr-1
+Namespace:   ns
+Task Ref:    t1
+
+Status
+STARTED          DURATION    STATUS
+10 minutes ago   ---         Running(Pending)
+
+Input Resources
+No resources
+
+Output Resources
+No resources
+
+Params
+No params
+
+Steps
+No steps
+
+Pod Conditions
+TYPE    STATUS   REASON               MESSAGE
+Ready   False    ContainersNotReady   containers with unready status: [step-build]
+
+Waiting Containers
+NAME         REASON             MESSAGE
+step-build   ImagePullBackOff   Back-off pulling image "busybox:nope"
+
+Events
+LAST SEEN       TYPE      OBJECT         REASON      MESSAGE
+9 minutes ago   Normal    pod/tr-1-pod   Scheduled   Successfully assigned ns/tr-1-pod to node-1
+8 minutes ago   Warning   pod/tr-1-pod   Failed      Failed to pull image "busybox:nope"
+`
+
+	test.AssertOutput(t, expected, actual)
+}
+
+func TestTaskRunDescribe_events_forbidden(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	trs := []*v1alpha1.TaskRun{
+		tb.TaskRun("tr-1", "ns",
+			tb.TaskRunSpec(tb.TaskRunTaskRef("t1")),
+			tb.TaskRunStatus(
+				tb.StatusCondition(apis.Condition{
+					Status: corev1.ConditionTrue,
+					Reason: resources.ReasonSucceeded,
+				}),
+				tb.TaskRunStartTime(clock.Now()),
+				cb.TaskRunCompletionTime(clock.Now().Add(5*time.Minute)),
+			),
+		),
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		TaskRuns: trs,
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ns",
+				},
+			},
+		},
+	})
+	cs.Kube.PrependReactor("list", "events", func(action k8stest.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("events is forbidden")
+	})
+
+	p := &test.Params{Tekton: cs.Pipeline, Clock: clock, Kube: cs.Kube}
+
+	taskrun := Command(p)
+	actual, err := test.ExecuteCommand(taskrun, "desc", "tr-1", "-n", "ns")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(actual, "Failed to list the events of taskrun tr-1: events is forbidden\n") {
+		t.Errorf("Expected a warning about the events, got: %s", actual)
+	}
+}
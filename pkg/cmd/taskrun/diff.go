@@ -0,0 +1,95 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package taskrun
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
+	"github.com/tektoncd/cli/pkg/helper/diff"
+	validate "github.com/tektoncd/cli/pkg/helper/validate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func diffCommand(p cli.Params) *cobra.Command {
+	all := false
+	eg := `
+# Show what differs between the TaskRuns 'good' and 'bad' in namespace 'bar'
+tkn tr diff good bad -n bar
+
+# Show all the fields of the TaskRuns 'good' and 'bad' side by side
+tkn tr diff good bad -n bar --all
+`
+
+	c := &cobra.Command{
+		Use:          "diff",
+		Short:        "Show the differences between two taskruns",
+		Example:      eg,
+		Args:         cobra.ExactArgs(2),
+		SilenceUsage: true,
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validate.NamespaceExists(p); err != nil {
+				return err
+			}
+
+			return printTaskRunDiff(cmd.OutOrStdout(), p, [2]string{args[0], args[1]}, all)
+		},
+	}
+
+	c.Flags().BoolVarP(&all, "all", "", false, "show all the fields, not only the ones which differ")
+
+	completion.RegisterArgs(c, 2, "taskrun")
+	return c
+}
+
+// printTaskRunDiff compares the params, resources, service account, status,
+// duration, step images and resource results of the taskruns
+func printTaskRunDiff(out io.Writer, p cli.Params, trNames [2]string, all bool) error {
+	cs, err := p.Clients()
+	if err != nil {
+		return fmt.Errorf("failed to create tekton client")
+	}
+
+	f := diff.NewFields()
+	for i, trName := range trNames {
+		tr, err := cs.Tekton.TektonV1alpha1().TaskRuns(p.Namespace()).Get(trName, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to find taskrun %q", trName)
+		}
+
+		f.Set(i, "task", validate.TaskRefExists(tr.Spec))
+		f.Set(i, "service account", tr.Spec.ServiceAccountName)
+		f.SetTaskRunStatus(i, "", &tr.Status, diff.StepImages(cs.Kube, tr.Namespace, &tr.Status, tr.Spec.TaskSpec))
+		f.SetParams(i, "", tr.Spec.Inputs.Params)
+		for _, r := range tr.Spec.Inputs.Resources {
+			if err := f.SetResource(i, cs.Tekton, p.Namespace(), "input ", r.PipelineResourceBinding); err != nil {
+				return err
+			}
+		}
+		for _, r := range tr.Spec.Outputs.Resources {
+			if err := f.SetResource(i, cs.Tekton, p.Namespace(), "output ", r.PipelineResourceBinding); err != nil {
+				return err
+			}
+		}
+	}
+
+	return f.Print(out, trNames, all)
+}
@@ -0,0 +1,105 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package taskrun
+
+import (
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	tb "github.com/tektoncd/pipeline/test/builder"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestTaskRunDiff(t *testing.T) {
+	taskRun := func(name, sa, image, commit string, status corev1.ConditionStatus) *v1alpha1.TaskRun {
+		return tb.TaskRun(name, "ns",
+			tb.TaskRunSpec(
+				tb.TaskRunTaskRef("build"),
+				tb.TaskRunServiceAccountName(sa),
+				tb.TaskRunInputs(
+					tb.TaskRunInputsParam("flags", "-v"),
+					tb.TaskRunInputsResource("source", tb.TaskResourceBindingRef("repo")),
+				),
+				tb.TaskRunOutputs(tb.TaskRunOutputsResource("image", tb.TaskResourceBindingRef(image))),
+			),
+			tb.TaskRunStatus(
+				tb.StatusCondition(apis.Condition{Type: apis.ConditionSucceeded, Status: status}),
+				tb.PodName(name+"-pod"),
+				tb.StepState(func(s *v1alpha1.StepState) {
+					s.Name = "build"
+					s.ImageID = "docker.io/golang@sha256:aaa"
+				}),
+				func(s *v1alpha1.TaskRunStatus) {
+					s.ResourcesResult = []v1alpha1.PipelineResourceResult{
+						{Key: "commit", Value: commit, ResourceRef: v1alpha1.PipelineResourceRef{Name: "repo"}},
+					}
+				},
+			),
+		)
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		TaskRuns: []*v1alpha1.TaskRun{
+			taskRun("good", "builder", "image", "abc123", corev1.ConditionTrue),
+			taskRun("bad", "default", "missing-image", "def456", corev1.ConditionFalse),
+		},
+		PipelineResources: []*v1alpha1.PipelineResource{
+			tb.PipelineResource("repo", "ns", tb.PipelineResourceSpec(v1alpha1.PipelineResourceTypeGit,
+				tb.PipelineResourceSpecParam("revision", "main"),
+			)),
+			tb.PipelineResource("image", "ns", tb.PipelineResourceSpec(v1alpha1.PipelineResourceTypeImage,
+				tb.PipelineResourceSpecParam("url", "quay.io/tekton/cli"),
+			)),
+		},
+		Pods: []*corev1.Pod{
+			tb.Pod("good-pod", "ns", tb.PodSpec(tb.PodContainer("step-build", "golang:1.13"))),
+		},
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ns",
+				},
+			},
+		},
+	})
+
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+	taskrun := Command(p)
+	got, err := test.ExecuteCommand(taskrun, "diff", "good", "bad", "-n", "ns", "--all")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	expected := `FIELD                            good                          bad
+task                             build                         build
+service account                  builder                       default
+status                           Succeeded                     Failed
+duration                         ---                           ---
+step build status                ---                           ---
+step build image                 golang:1.13                   ---
+step build image ID              docker.io/golang@sha256:aaa   docker.io/golang@sha256:aaa
+result repo commit               abc123                        def456
+param flags                      -v                            -v
+input resource source            repo                          repo
+input resource source revision   main                          main
+output resource image            image                         missing-image
+output resource image url        quay.io/tekton/cli            ---
+`
+	test.AssertOutput(t, expected, got)
+}
@@ -16,17 +16,21 @@ package taskrun
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"text/tabwriter"
+	"time"
 
 	"github.com/jonboulle/clockwork"
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/cli/pkg/helper/options"
 	trhsort "github.com/tektoncd/cli/pkg/helper/taskrun/sort"
-	validate "github.com/tektoncd/cli/pkg/helper/validate"
+	"github.com/tektoncd/cli/pkg/helper/watch"
 	"github.com/tektoncd/cli/pkg/printer"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	informers "github.com/tektoncd/pipeline/pkg/client/informers/externalversions"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	cliopts "k8s.io/cli-runtime/pkg/genericclioptions"
@@ -36,8 +40,15 @@ const (
 	emptyMsg = "No taskruns found"
 )
 
+// watchInterval is how often the table of the watched taskruns is redrawn so
+// that their age keeps ticking
+const watchInterval = time.Second
+
 type ListOptions struct {
 	Limit int
+	Watch bool
+	Wide  bool
+	options.ListOptions
 }
 
 func listCommand(p cli.Params) *cobra.Command {
@@ -50,6 +61,15 @@ tkn tr list -n bar
 
 # List all taskruns of task 'foo' in namespace 'bar'
 tkn taskrun list foo -n bar
+
+# List the running taskruns with the label 'app=web' in namespace 'bar'
+tkn tr list -n bar -l app=web --status running
+
+# Keep watching the taskruns of task 'foo' as they progress
+tkn tr list foo -n bar -w
+
+# List the taskruns with their task, pod and failed step
+tkn tr list -n bar -o wide
 `
 
 	c := &cobra.Command{
@@ -67,7 +87,7 @@ tkn taskrun list foo -n bar
 				task = args[0]
 			}
 
-			if err := validate.NamespaceExists(p); err != nil {
+			if err := opts.ValidateNamespace(p); err != nil {
 				return err
 			}
 
@@ -76,9 +96,7 @@ tkn taskrun list foo -n bar
 				return nil
 			}
 
-			trs, err := list(p, task, opts.Limit)
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "Failed to list taskruns from %s namespace \n", p.Namespace())
+			if err := opts.Validate(); err != nil {
 				return err
 			}
 
@@ -88,6 +106,28 @@ tkn taskrun list foo -n bar
 				return err
 			}
 
+			if output == printer.OutputWide {
+				opts.Wide = true
+				output = ""
+			}
+
+			if opts.Watch {
+				if output != "" {
+					return fmt.Errorf("--watch can not be used with --output")
+				}
+				stream := &cli.Stream{
+					Out: cmd.OutOrStdout(),
+					Err: cmd.OutOrStderr(),
+				}
+				return watchRuns(stream, p, task, opts, watch.Interrupted())
+			}
+
+			trs, err := list(p, task, opts)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Failed to list taskruns from %s namespace \n", p.Namespace())
+				return err
+			}
+
 			if output != "" && trs != nil {
 				return printer.PrintObject(cmd.OutOrStdout(), trs, f)
 			}
@@ -98,7 +138,7 @@ tkn taskrun list foo -n bar
 			}
 
 			if trs != nil {
-				err = printFormatted(stream, trs, p.Time())
+				err = printFormatted(stream, trs, p.Time(), opts)
 			}
 
 			if err != nil {
@@ -110,37 +150,76 @@ tkn taskrun list foo -n bar
 		},
 	}
 
-	f.AddFlags(c)
+	printer.AddListFlags(c, f, printer.OutputWide)
+	opts.AddRunFlags(c)
+	opts.AddAllNamespacesFlag(c)
 	c.Flags().IntVarP(&opts.Limit, "limit", "", 0, "limit taskruns listed (default: return all taskruns)")
+	c.Flags().BoolVarP(&opts.Watch, "watch", "w", false, "watch the taskruns and redraw the table as they are created, progress and complete")
 
 	return c
 }
 
-func list(p cli.Params, task string, limit int) (*v1alpha1.TaskRunList, error) {
+func list(p cli.Params, task string, opts *ListOptions) (*v1alpha1.TaskRunList, error) {
 	cs, err := p.Clients()
 	if err != nil {
 		return nil, err
 	}
 
-	options := v1.ListOptions{}
+	var selector string
 	if task != "" {
-		options = v1.ListOptions{
-			LabelSelector: fmt.Sprintf("tekton.dev/task=%s", task),
-		}
+		selector = fmt.Sprintf("tekton.dev/task=%s", task)
+	}
+	listOpts := v1.ListOptions{
+		LabelSelector: opts.LabelSelector(selector),
 	}
 
-	trc := cs.Tekton.TektonV1alpha1().TaskRuns(p.Namespace())
-	trs, err := trc.List(options)
+	trc := cs.Tekton.TektonV1alpha1().TaskRuns(opts.Namespace(p))
+	trs, err := trc.List(listOpts)
 	if err != nil {
 		return nil, err
 	}
 
-	trslen := len(trs.Items)
+	trs.Items = sortAndLimit(filter(p, trs.Items, opts), opts)
+
+	// NOTE: this is required for -o json|yaml to work properly since
+	// tektoncd go client fails to set these; probably a bug
+	trs.GetObjectKind().SetGroupVersionKind(
+		schema.GroupVersionKind{
+			Version: "tekton.dev/v1alpha1",
+			Kind:    "TaskRunList",
+		})
+
+	return trs, nil
+}
+
+func filter(p cli.Params, trs []v1alpha1.TaskRun, opts *ListOptions) []v1alpha1.TaskRun {
+	now := p.Time().Now()
+	filtered := trs[:0]
+	for _, tr := range trs {
+		if opts.MatchRun(tr.Status.Conditions, tr.Status.StartTime, now) {
+			filtered = append(filtered, tr)
+		}
+	}
+	return filtered
+}
+
+func sortAndLimit(trs []v1alpha1.TaskRun, opts *ListOptions) []v1alpha1.TaskRun {
+	trslen := len(trs)
 
 	if trslen != 0 {
-		trs.Items = trhsort.SortTaskRunsByStartTime(trs.Items)
+		trs = trhsort.SortTaskRunsByStartTime(trs)
+		switch opts.SortBy {
+		case options.SortByName:
+			trs = trhsort.SortTaskRunsByName(trs)
+		case options.SortByDuration:
+			trs = trhsort.SortTaskRunsByDuration(trs)
+		case options.SortByStatus:
+			trs = trhsort.SortTaskRunsByStatus(trs)
+		}
 	}
 
+	limit := opts.Limit
+
 	// If greater than maximum amount of taskruns, return all taskruns by setting limit to default
 	if limit > trslen {
 		limit = 0
@@ -148,36 +227,115 @@ func list(p cli.Params, task string, limit int) (*v1alpha1.TaskRunList, error) {
 
 	// Return all taskruns if limit is 0 or is same as trslen
 	if limit != 0 && trslen > limit {
-		trs.Items = trs.Items[0:limit]
+		trs = trs[0:limit]
 	}
+	return trs
+}
 
-	// NOTE: this is required for -o json|yaml to work properly since
-	// tektoncd go client fails to set these; probably a bug
-	trs.GetObjectKind().SetGroupVersionKind(
-		schema.GroupVersionKind{
-			Version: "tekton.dev/v1alpha1",
-			Kind:    "TaskRunList",
-		})
+// watchRuns draws the table of the taskruns kept up to date by an informer,
+// and redraws it whenever they change until stop is closed
+func watchRuns(s *cli.Stream, p cli.Params, task string, opts *ListOptions, stop <-chan struct{}) error {
+	cs, err := p.Clients()
+	if err != nil {
+		return err
+	}
 
-	return trs, nil
+	var selector string
+	if task != "" {
+		selector = fmt.Sprintf("tekton.dev/task=%s", task)
+	}
+	factory := informers.NewSharedInformerFactoryWithOptions(
+		cs.Tekton,
+		0,
+		informers.WithNamespace(opts.Namespace(p)),
+		informers.WithTweakListOptions(func(o *v1.ListOptions) {
+			o.LabelSelector = opts.LabelSelector(selector)
+		}))
+	informer := factory.Tekton().V1alpha1().TaskRuns().Informer()
+	changes := watch.Changes(informer)
+
+	factory.Start(stop)
+	factory.WaitForCacheSync(stop)
+
+	table := &watch.Table{
+		Out:      s.Out,
+		Clock:    p.Time(),
+		Interval: watchInterval,
+		Render: func(w io.Writer) error {
+			trs := &v1alpha1.TaskRunList{}
+			for _, obj := range informer.GetStore().List() {
+				if tr, ok := obj.(*v1alpha1.TaskRun); ok {
+					trs.Items = append(trs.Items, *tr.DeepCopy())
+				}
+			}
+			trs.Items = sortAndLimit(filter(p, trs.Items, opts), opts)
+			return printFormatted(&cli.Stream{Out: w, Err: w}, trs, p.Time(), opts)
+		},
+	}
+	return table.Run(changes, stop)
 }
 
-func printFormatted(s *cli.Stream, trs *v1alpha1.TaskRunList, c clockwork.Clock) error {
+func printFormatted(s *cli.Stream, trs *v1alpha1.TaskRunList, c clockwork.Clock, opts *ListOptions) error {
 	if len(trs.Items) == 0 {
 		fmt.Fprintln(s.Err, emptyMsg)
 		return nil
 	}
 
 	w := tabwriter.NewWriter(s.Out, 0, 5, 3, ' ', tabwriter.TabIndent)
-	fmt.Fprintln(w, "NAME\tSTARTED\tDURATION\tSTATUS\t")
+	if opts.AllNamespaces {
+		fmt.Fprint(w, "NAMESPACE\t")
+	}
+	fmt.Fprint(w, "NAME\tSTARTED\tDURATION\tSTATUS\t")
+	if opts.Wide {
+		fmt.Fprint(w, "TASK\tSERVICE ACCOUNT\tPIPELINERUN\tPOD\tFAILED STEP\t")
+	}
+	fmt.Fprintln(w)
+
 	for _, tr := range trs.Items {
+		if opts.AllNamespaces {
+			fmt.Fprintf(w, "%s\t", tr.Namespace)
+		}
 
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n",
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t",
 			tr.Name,
 			formatted.Age(tr.Status.StartTime, c),
 			formatted.Duration(tr.Status.StartTime, tr.Status.CompletionTime),
 			formatted.Condition(tr.Status.Conditions),
 		)
+		if opts.Wide {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t",
+				orNone(taskName(tr)),
+				orNone(tr.Spec.ServiceAccountName),
+				orNone(tr.Labels["tekton.dev/pipelineRun"]),
+				orNone(tr.Status.PodName),
+				orNone(failedStep(tr)),
+			)
+		}
+		fmt.Fprintln(w)
 	}
 	return w.Flush()
 }
+
+func taskName(tr v1alpha1.TaskRun) string {
+	if tr.Spec.TaskRef == nil {
+		return ""
+	}
+	return tr.Spec.TaskRef.Name
+}
+
+// failedStep returns the first step which exited with a non zero code
+func failedStep(tr v1alpha1.TaskRun) string {
+	for _, s := range tr.Status.Steps {
+		if s.Terminated != nil && s.Terminated.ExitCode != 0 {
+			return s.Name
+		}
+	}
+	return ""
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "---"
+	}
+	return s
+}
@@ -22,7 +22,9 @@ import (
 
 	"github.com/jonboulle/clockwork"
 	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
 	"github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/resources"
 	pipelinetest "github.com/tektoncd/pipeline/test"
@@ -207,6 +209,39 @@ func TestListTaskRuns(t *testing.T) {
 			},
 			wantError: false,
 		},
+		{
+			name:    "by selector and status",
+			command: command(t, trs, now, ns),
+			args:    []string{"list", "-n", "foo", "-l", "tekton.dev/Task=random", "--status", "failed"},
+			expected: []string{
+				"NAME    STARTED          DURATION   STATUS   ",
+				"tr3-1   ---              ---        Failed   ",
+				"tr2-2   59 minutes ago   1 minute   Failed   ",
+				"",
+			},
+			wantError: false,
+		},
+		{
+			name:    "sort by name",
+			command: command(t, trs, now, ns),
+			args:    []string{"list", "-n", "foo", "--sort-by", "name", "--status", "succeeded"},
+			expected: []string{
+				"NAME    STARTED      DURATION   STATUS      ",
+				"tr0-1   ---          ---        Succeeded   ",
+				"tr1-1   1 hour ago   1 minute   Succeeded   ",
+				"",
+			},
+			wantError: false,
+		},
+		{
+			name:    "invalid sort key",
+			command: command(t, trs, now, ns),
+			args:    []string{"list", "-n", "foo", "--sort-by", "age"},
+			expected: []string{
+				"Error: invalid sort key \"age\", must be one of: start-time|duration|name|status\n",
+			},
+			wantError: true,
+		},
 		{
 			name:    "error from invalid namespace",
 			command: command(t, trs, now, ns),
@@ -283,3 +318,174 @@ func taskRunCompletionTime(ct time.Time) tb.TaskRunStatusOp {
 		s.CompletionTime = &metav1.Time{Time: ct}
 	}
 }
+
+func TestListTaskRuns_all_namespaces(t *testing.T) {
+	now := time.Now()
+
+	trs := []*v1alpha1.TaskRun{
+		tb.TaskRun("tr1-1", "ns-1",
+			tb.TaskRunLabel("tekton.dev/task", "bar"),
+			tb.TaskRunStatus(
+				tb.StatusCondition(apis.Condition{
+					Status: corev1.ConditionTrue,
+					Reason: resources.ReasonSucceeded,
+				}),
+				tb.TaskRunStartTime(now),
+				taskRunCompletionTime(now.Add(time.Minute)),
+			),
+		),
+		tb.TaskRun("tr2-1", "ns-2",
+			tb.TaskRunLabel("tekton.dev/task", "bar"),
+			tb.TaskRunStatus(
+				tb.StatusCondition(apis.Condition{
+					Status: corev1.ConditionUnknown,
+					Reason: resources.ReasonRunning,
+				}),
+				tb.TaskRunStartTime(now.Add(time.Minute)),
+			),
+		),
+	}
+
+	got, err := test.ExecuteCommand(command(t, trs, now, nil), "list", "bar", "-A")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	expected := []string{
+		"NAMESPACE   NAME    STARTED          DURATION   STATUS      ",
+		"ns-2        tr2-1   59 minutes ago   ---        Running     ",
+		"ns-1        tr1-1   1 hour ago       1 minute   Succeeded   ",
+		"",
+	}
+	test.AssertOutput(t, strings.Join(expected, "\n"), got)
+}
+
+func TestListTaskRuns_watch(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+	trs := []*v1alpha1.TaskRun{
+		tb.TaskRun("tr-1", "ns",
+			tb.TaskRunLabel("tekton.dev/task", "task"),
+			tb.TaskRunStatus(
+				tb.StatusCondition(apis.Condition{
+					Status: corev1.ConditionTrue,
+					Reason: resources.ReasonSucceeded,
+				}),
+				tb.TaskRunStartTime(clock.Now().Add(-10*time.Minute)),
+				taskRunCompletionTime(clock.Now().Add(-9*time.Minute)),
+			),
+		),
+		tb.TaskRun("tr-other", "ns",
+			tb.TaskRunLabel("tekton.dev/task", "other"),
+		),
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{TaskRuns: trs, Namespaces: ns})
+	p := &test.Params{Tekton: cs.Pipeline, Clock: clock, Kube: cs.Kube}
+
+	out := &test.SyncBuffer{}
+	stop := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- watchRuns(&cli.Stream{Out: out, Err: out}, p, "task", &ListOptions{}, stop)
+	}()
+
+	out.WaitForSuffix(t, strings.Join([]string{
+		"NAME   STARTED          DURATION   STATUS      ",
+		"tr-1   10 minutes ago   1 minute   Succeeded   ",
+		"",
+	}, "\n"))
+
+	_, err := cs.Pipeline.TektonV1alpha1().TaskRuns("ns").Create(
+		tb.TaskRun("tr-2", "ns",
+			tb.TaskRunLabel("tekton.dev/task", "task"),
+			tb.TaskRunStatus(
+				tb.StatusCondition(apis.Condition{
+					Status: corev1.ConditionFalse,
+					Reason: resources.ReasonFailed,
+				}),
+				tb.TaskRunStartTime(clock.Now().Add(-3*time.Minute)),
+				taskRunCompletionTime(clock.Now().Add(-1*time.Minute)),
+			),
+		),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	out.WaitForSuffix(t, strings.Join([]string{
+		"NAME   STARTED          DURATION    STATUS      ",
+		"tr-2   3 minutes ago    2 minutes   Failed      ",
+		"tr-1   10 minutes ago   1 minute    Succeeded   ",
+		"",
+	}, "\n"))
+
+	close(stop)
+	if err := <-done; err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestListTaskRuns_wide(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	trs := []*v1alpha1.TaskRun{
+		tb.TaskRun("tr-1", "ns",
+			tb.TaskRunLabel("tekton.dev/pipelineRun", "pr-1"),
+			tb.TaskRunSpec(
+				tb.TaskRunTaskRef("build"),
+				tb.TaskRunServiceAccountName("builder"),
+			),
+			tb.TaskRunStatus(
+				tb.StatusCondition(apis.Condition{
+					Status: corev1.ConditionFalse,
+					Reason: resources.ReasonFailed,
+				}),
+				tb.PodName("tr-1-pod"),
+				tb.StepState(cb.StepName("fetch"), tb.StateTerminated(0)),
+				tb.StepState(cb.StepName("compile"), tb.StateTerminated(2)),
+				tb.TaskRunStartTime(clock.Now().Add(-10*time.Minute)),
+				taskRunCompletionTime(clock.Now().Add(-9*time.Minute)),
+			),
+		),
+		tb.TaskRun("tr-2", "ns",
+			tb.TaskRunSpec(
+				tb.TaskRunTaskSpec(tb.Step("hello", "busybox")),
+			),
+			tb.TaskRunStatus(
+				tb.StatusCondition(apis.Condition{
+					Status: corev1.ConditionTrue,
+					Reason: resources.ReasonSucceeded,
+				}),
+				tb.TaskRunStartTime(clock.Now().Add(-20*time.Minute)),
+				taskRunCompletionTime(clock.Now().Add(-19*time.Minute)),
+			),
+		),
+	}
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	got, err := test.ExecuteCommand(command(t, trs, clock.Now(), ns), "list", "-n", "ns", "-o", "wide")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	expected := strings.Join([]string{
+		"NAME   STARTED      DURATION   STATUS      TASK    SERVICE ACCOUNT   PIPELINERUN   POD        FAILED STEP   ",
+		"tr-1   1 hour ago   1 minute   Failed      build   builder           pr-1          tr-1-pod   compile       ",
+		"tr-2   1 hour ago   1 minute   Succeeded   ---     ---               ---           ---        ---           ",
+		"",
+	}, "\n")
+	test.AssertOutput(t, expected, got)
+}
@@ -48,15 +48,16 @@ type Log struct {
 }
 
 type LogReader struct {
-	Task     string
-	Run      string
-	Number   int
-	Ns       string
-	Clients  *cli.Clients
-	Streamer stream.NewStreamerFunc
-	Follow   bool
-	AllSteps bool
-	Stream   *cli.Stream
+	Task       string
+	Run        string
+	Number     int
+	Ns         string
+	Clients    *cli.Clients
+	Streamer   stream.NewStreamerFunc
+	Follow     bool
+	AllSteps   bool
+	Timestamps bool
+	Stream     *cli.Stream
 }
 
 func (lr *LogReader) Read() (<-chan Log, <-chan error, error) {
@@ -162,7 +163,7 @@ func (lr *LogReader) readStepsLogs(steps []*step, pod *pods.Pod, follow bool) (<
 			}
 
 			container := pod.Container(step.container)
-			podC, perrC, err := container.LogReader(follow).Read()
+			podC, perrC, err := container.LogReader(follow, lr.Timestamps).Read()
 			if err != nil {
 				errC <- fmt.Errorf("error in getting logs for step %s: %s", step.name, err)
 				continue
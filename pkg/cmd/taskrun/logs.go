@@ -20,6 +20,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
 	"github.com/tektoncd/cli/pkg/helper/options"
 	"github.com/tektoncd/cli/pkg/helper/pods"
 	trlist "github.com/tektoncd/cli/pkg/helper/taskrun/list"
@@ -68,9 +69,10 @@ tkn taskrun logs -f foo -n bar
 
 	c.Flags().BoolVarP(&opts.AllSteps, "all", "a", false, "show all logs including init steps injected by tekton")
 	c.Flags().BoolVarP(&opts.Follow, "follow", "f", false, "stream live logs")
+	c.Flags().BoolVarP(&opts.Timestamps, "timestamps", "", false, "show the timestamp of each log line")
 	c.Flags().IntVarP(&opts.Limit, "limit", "", 5, "lists number of taskruns")
 
-	_ = c.MarkZshCompPositionalArgumentCustom(1, "__tkn_get_taskrun")
+	completion.RegisterArgs(c, 1, "taskrun")
 	return c
 }
 
@@ -92,13 +94,14 @@ func Run(opts *options.LogOptions) error {
 	}
 
 	lr := &LogReader{
-		Run:      opts.TaskrunName,
-		Ns:       opts.Params.Namespace(),
-		Clients:  cs,
-		Streamer: streamer,
-		Stream:   opts.Stream,
-		Follow:   opts.Follow,
-		AllSteps: opts.AllSteps,
+		Run:        opts.TaskrunName,
+		Ns:         opts.Params.Namespace(),
+		Clients:    cs,
+		Streamer:   streamer,
+		Stream:     opts.Stream,
+		Follow:     opts.Follow,
+		AllSteps:   opts.AllSteps,
+		Timestamps: opts.Timestamps,
 	}
 
 	logC, errC, err := lr.Read()
@@ -0,0 +1,152 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package version
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+const (
+	unknownVersion = "unknown"
+
+	controllerDeployment = "tekton-pipelines-controller"
+	releaseLabel         = "pipeline.tekton.dev/release"
+	versionLabel         = "version"
+
+	// compatiblePipelines is the range of Tekton Pipelines releases the
+	// client is built and tested against
+	compatiblePipelines = ">=0.8.0 <0.10.0"
+)
+
+// pipelinesNamespaces are the namespaces Tekton Pipelines is installed in,
+// by the upstream release and by the OpenShift operator
+var pipelinesNamespaces = []string{"tekton-pipelines", "openshift-pipelines"}
+
+// Server describes the Tekton Pipelines installation of the cluster
+type Server struct {
+	Version     string   `json:"version"`
+	APIVersions []string `json:"apiVersions"`
+}
+
+// serverInfo detects the version of Tekton Pipelines and the tekton.dev API
+// versions served by the cluster, an installation which can't be found or
+// read has an unknown version
+func serverInfo(kube k8s.Interface) (*Server, error) {
+	server := &Server{Version: unknownVersion}
+
+	for _, ns := range pipelinesNamespaces {
+		d, err := kube.AppsV1().Deployments(ns).Get(controllerDeployment, metav1.GetOptions{})
+		if errors.IsNotFound(err) || errors.IsForbidden(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if v := deploymentVersion(d.Labels, d.Spec.Template.Spec.Containers); v != "" {
+			server.Version = v
+		}
+		break
+	}
+
+	groups, err := kube.Discovery().ServerGroups()
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range groups.Groups {
+		if g.Name != pipeline.GroupName {
+			continue
+		}
+		for _, v := range g.Versions {
+			server.APIVersions = append(server.APIVersions, v.Version)
+		}
+	}
+	sort.Strings(server.APIVersions)
+
+	return server, nil
+}
+
+// deploymentVersion returns the release of the controller from its labels,
+// falling back to the tag of its image for development builds
+func deploymentVersion(labels map[string]string, containers []corev1.Container) string {
+	for _, l := range []string{releaseLabel, versionLabel} {
+		if v := labels[l]; v != "" && v != "devel" {
+			return v
+		}
+	}
+
+	for _, c := range containers {
+		if v := imageTag(c.Image); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// imageTag returns the tag of an image reference, ignoring its digest
+func imageTag(image string) string {
+	image = strings.SplitN(image, "@", 2)[0]
+	i := strings.LastIndex(image, ":")
+	if i < 0 || strings.Contains(image[i:], "/") {
+		return ""
+	}
+	return image[i+1:]
+}
+
+// compatibility returns warnings for a server the client might not be
+// able to work with
+func compatibility(server *Server) []string {
+	var warnings []string
+
+	if len(server.APIVersions) == 0 {
+		warnings = append(warnings, "the tekton.dev API is not served by the cluster, is Tekton Pipelines installed?")
+	} else if !servesSupportedAPI(server.APIVersions) {
+		warnings = append(warnings, fmt.Sprintf("the cluster serves tekton.dev/%s, the client supports tekton.dev/%s",
+			strings.Join(server.APIVersions, ", "), strings.Join(cli.SupportedAPIVersions, ", ")))
+	}
+
+	if server.Version == unknownVersion {
+		return warnings
+	}
+	v, err := parseVersion(server.Version)
+	if err != nil {
+		return warnings
+	}
+	if !semver.MustParseRange(compatiblePipelines)(*v) {
+		warnings = append(warnings, fmt.Sprintf("Tekton Pipelines %s is not supported, the client supports releases %s",
+			server.Version, compatiblePipelines))
+	}
+	return warnings
+}
+
+func servesSupportedAPI(versions []string) bool {
+	for _, v := range versions {
+		for _, s := range cli.SupportedAPIVersions {
+			if v == s {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,181 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package version
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/cli/pkg/test"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stest "k8s.io/client-go/testing"
+)
+
+func controller(ns string, labels map[string]string, image string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      controllerDeployment,
+			Namespace: ns,
+			Labels:    labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "tekton-pipelines-controller", Image: image}},
+				},
+			},
+		},
+	}
+}
+
+func fakeKube(apiVersions []string, objects ...runtime.Object) k8s.Interface {
+	cs := fake.NewSimpleClientset(objects...)
+	for _, v := range apiVersions {
+		cs.Discovery().(*fakediscovery.FakeDiscovery).Resources = append(
+			cs.Discovery().(*fakediscovery.FakeDiscovery).Resources,
+			&metav1.APIResourceList{GroupVersion: "tekton.dev/" + v},
+		)
+	}
+	return cs
+}
+
+func fakeCluster(release string, apiVersions ...string) k8s.Interface {
+	labels := map[string]string{releaseLabel: release}
+	return fakeKube(apiVersions, controller("tekton-pipelines", labels, "gcr.io/tekton-releases/controller:"+release))
+}
+
+func TestServerInfo(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		kube     k8s.Interface
+		expected *Server
+	}{
+		{
+			name:     "release label",
+			kube:     fakeCluster("v0.9.2", "v1alpha1"),
+			expected: &Server{Version: "v0.9.2", APIVersions: []string{"v1alpha1"}},
+		},
+		{
+			name: "image tag of a development build",
+			kube: fakeKube([]string{"v1alpha2", "v1alpha1"},
+				controller("tekton-pipelines", map[string]string{releaseLabel: "devel"},
+					"gcr.io/tekton-releases/github.com/tektoncd/pipeline/cmd/controller:v0.10.0@sha256:0123")),
+			expected: &Server{Version: "v0.10.0", APIVersions: []string{"v1alpha1", "v1alpha2"}},
+		},
+		{
+			name: "openshift namespace",
+			kube: fakeKube([]string{"v1alpha1"},
+				controller("openshift-pipelines", map[string]string{versionLabel: "v0.8.0"}, "registry:5000/controller")),
+			expected: &Server{Version: "v0.8.0", APIVersions: []string{"v1alpha1"}},
+		},
+		{
+			name:     "not installed",
+			kube:     fakeKube(nil),
+			expected: &Server{Version: unknownVersion},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			server, err := serverInfo(s.kube)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, server)
+		})
+	}
+}
+
+func TestImageTag(t *testing.T) {
+	assert.Equal(t, "v0.9.2", imageTag("gcr.io/tekton/controller:v0.9.2"))
+	assert.Equal(t, "v0.9.2", imageTag("gcr.io/tekton/controller:v0.9.2@sha256:0123"))
+	assert.Equal(t, "", imageTag("gcr.io/tekton/controller@sha256:0123"))
+	assert.Equal(t, "", imageTag("localhost:5000/controller"))
+}
+
+func TestCompatibility(t *testing.T) {
+	assert.Empty(t, compatibility(&Server{Version: "v0.9.2", APIVersions: []string{"v1alpha1"}}))
+	assert.Empty(t, compatibility(&Server{Version: unknownVersion, APIVersions: []string{"v1alpha1"}}))
+	assert.Equal(t, []string{
+		"the cluster serves tekton.dev/v1beta1, the client supports tekton.dev/v1alpha1",
+		"Tekton Pipelines v0.11.0 is not supported, the client supports releases " + compatiblePipelines,
+	}, compatibility(&Server{Version: "v0.11.0", APIVersions: []string{"v1beta1"}}))
+	assert.Empty(t, compatibility(&Server{Version: "v0.9.2", APIVersions: []string{"v1alpha1", "v1alpha2"}}))
+	assert.Equal(t, []string{
+		"the cluster serves tekton.dev/v1alpha2, the client supports tekton.dev/v1alpha1",
+	}, compatibility(&Server{Version: "v0.9.2", APIVersions: []string{"v1alpha2"}}))
+	assert.Equal(t, []string{
+		"the tekton.dev API is not served by the cluster, is Tekton Pipelines installed?",
+	}, compatibility(&Server{Version: unknownVersion}))
+}
+
+func TestVersion_server(t *testing.T) {
+	v := clientVersion
+	defer func() { clientVersion = v }()
+	clientVersion = "v0.7.0"
+
+	version := Command(&test.Params{Kube: fakeCluster("v0.11.0", "v1alpha1")})
+	got, err := test.ExecuteCommand(version)
+	assert.NoError(t, err)
+	expected := `Client version: v0.7.0
+Pipeline version: v0.11.0
+Pipeline API versions: v1alpha1
+Warning: Tekton Pipelines v0.11.0 is not supported, the client supports releases ` + compatiblePipelines + "\n"
+	assert.Equal(t, expected, got)
+
+	version = Command(&test.Params{Kube: fakeCluster("v0.9.2", "v1alpha1")})
+	got, err = test.ExecuteCommand(version, "-o", "json")
+	assert.NoError(t, err)
+	expected = `{
+  "client": "v0.7.0",
+  "pipeline": {
+    "version": "v0.9.2",
+    "apiVersions": [
+      "v1alpha1"
+    ]
+  }
+}
+`
+	assert.Equal(t, expected, got)
+
+	version = Command(&test.Params{Kube: fakeCluster("v0.9.2", "v1alpha1")})
+	_, err = test.ExecuteCommand(version, "-o", "yaml")
+	assert.EqualError(t, err, "output format specified is yaml but must be json")
+}
+
+func TestVersion_server_timeout(t *testing.T) {
+	v, timeout := clientVersion, clusterTimeout
+	defer func() { clientVersion, clusterTimeout = v, timeout }()
+	clientVersion, clusterTimeout = "v0.7.0", 10*time.Millisecond
+
+	kube := fakeCluster("v0.9.2", "v1alpha1").(*fake.Clientset)
+	kube.PrependReactor("get", "deployments", func(action k8stest.Action) (bool, runtime.Object, error) {
+		time.Sleep(time.Second)
+		return false, nil, nil
+	})
+
+	version := Command(&test.Params{Kube: kube})
+	got, err := test.ExecuteCommand(version)
+	assert.NoError(t, err)
+	expected := `Client version: v0.7.0
+Warning: failed to get the Tekton Pipelines version: the cluster did not answer within 10ms
+`
+	assert.Equal(t, expected, got)
+}
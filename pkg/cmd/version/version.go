@@ -17,6 +17,7 @@ package version
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
@@ -26,6 +27,8 @@ import (
 	"github.com/blang/semver"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/config"
 )
 
 // NOTE: use go build -ldflags "-X github.com/tektoncd/cli/pkg/cmd/version.clientVersion=$(git describe)"
@@ -34,34 +37,151 @@ var clientVersion = devVersion
 const devVersion = "dev"
 const latestReleaseURL = "https://api.github.com/repos/tektoncd/cli/releases/latest"
 
+// clusterTimeout bounds the lookup of the Tekton Pipelines version, the
+// client version is printed even when the cluster is unreachable
+var clusterTimeout = 5 * time.Second
+
+// Versions is the version information of the client and of the Tekton
+// Pipelines installation of the cluster
+type Versions struct {
+	Client   string   `json:"client"`
+	Pipeline *Server  `json:"pipeline,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
 // Command returns version command
-func Command() *cobra.Command {
+func Command(p cli.Params) *cobra.Command {
 	var check bool
+	var output string
 
 	var cmd = &cobra.Command{
 		Use:   "version",
 		Short: "Prints version information",
+		Long: `Prints the version of the client and of Tekton Pipelines on the cluster
+
+The version of Tekton Pipelines is read from the controller deployment and
+a warning is printed when the cluster serves no API version or runs no
+release the client supports. The client version is printed first, the
+lookup of the cluster gives up after a few seconds.`,
 		Annotations: map[string]string{
 			"commandType": "utility",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			fmt.Fprintf(cmd.OutOrStdout(), "Client version: %s\n", clientVersion)
+			if output != "" && output != "json" {
+				return fmt.Errorf("output format specified is %s but must be json", output)
+			}
+
+			versions := Versions{Client: clientVersion}
+			if output != "json" {
+				fmt.Fprintf(cmd.OutOrStdout(), "Client version: %s\n", versions.Client)
+			}
+
+			server, err := clusterInfo(p, cmd)
+			if err != nil {
+				versions.Warnings = append(versions.Warnings, fmt.Sprintf("failed to get the Tekton Pipelines version: %v", err))
+			} else {
+				versions.Pipeline = server
+				versions.Warnings = append(versions.Warnings, compatibility(server)...)
+			}
+
+			if output == "json" {
+				if err := printJSON(cmd.OutOrStdout(), versions); err != nil {
+					return err
+				}
+			} else {
+				printText(cmd.OutOrStdout(), cmd.OutOrStderr(), versions)
+			}
 
 			if !check || clientVersion == devVersion {
 				return nil
 			}
 
+			out := cmd.OutOrStdout()
+			if output == "json" {
+				out = cmd.OutOrStderr()
+			}
 			client := NewClient(time.Duration(3 * time.Second))
-			output, err := checkRelease(client)
-			fmt.Fprint(cmd.OutOrStdout(), output)
+			release, err := checkRelease(client)
+			fmt.Fprint(out, release)
 			return err
 		},
 	}
 
 	cmd.Flags().BoolVarP(&check, "check", "c", false, "check if a newer version is available")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json")
+	cmd.Flags().StringP("kubeconfig", "k", "", "kubectl config file (default: $HOME/.kube/config)")
+	cmd.Flags().String("context", "", "name of the kubeconfig context to use (default: kubectl config current-context)")
 	return cmd
 }
 
+// clusterInfo connects to the cluster of the kubeconfig flags, the
+// --context shorthand is taken by --check so flags.InitParams can't be used
+func clusterInfo(p cli.Params, cmd *cobra.Command) (*Server, error) {
+	kcPath, err := cmd.Flags().GetString("kubeconfig")
+	if err != nil {
+		return nil, err
+	}
+	p.SetKubeConfigPath(kcPath)
+
+	kContext, err := cmd.Flags().GetString("context")
+	if err != nil {
+		return nil, err
+	}
+	if kContext == "" {
+		cfg, err := config.Load()
+		if err != nil {
+			return nil, err
+		}
+		kContext = cfg.Context
+	}
+	p.SetKubeContext(kContext)
+
+	type result struct {
+		server *Server
+		err    error
+	}
+	done := make(chan result, 1)
+	go func() {
+		kube, err := p.KubeClient()
+		if err != nil {
+			done <- result{err: err}
+			return
+		}
+		server, err := serverInfo(kube)
+		done <- result{server: server, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.server, r.err
+	case <-time.After(clusterTimeout):
+		return nil, fmt.Errorf("the cluster did not answer within %s", clusterTimeout)
+	}
+}
+
+// printText prints the versions of the cluster, the client version is
+// printed before the cluster is looked up
+func printText(out, errOut io.Writer, versions Versions) {
+	if versions.Pipeline != nil {
+		fmt.Fprintf(out, "Pipeline version: %s\n", versions.Pipeline.Version)
+		if len(versions.Pipeline.APIVersions) > 0 {
+			fmt.Fprintf(out, "Pipeline API versions: %s\n", strings.Join(versions.Pipeline.APIVersions, ", "))
+		}
+	}
+	for _, w := range versions.Warnings {
+		fmt.Fprintf(errOut, "Warning: %s\n", w)
+	}
+}
+
+func printJSON(out io.Writer, versions Versions) error {
+	b, err := json.MarshalIndent(versions, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, string(b))
+	return nil
+}
+
 type GHVersion struct {
 	TagName string `json:"tag_name"`
 	HTMLURL string `json:"html_url"`
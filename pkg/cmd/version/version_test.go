@@ -73,10 +73,10 @@ func TestVersionGood(t *testing.T) {
 	}
 
 	clientVersion = "v1.2.3"
-	version := Command()
+	version := Command(&test.Params{Kube: fakeCluster("v0.9.2", "v1alpha1")})
 	got, err := test.ExecuteCommand(version, "version", "")
 	assert.Nil(t, err)
-	assert.Equal(t, "Client version: "+clientVersion+"\n", got)
+	assert.Equal(t, "Client version: "+clientVersion+"\nPipeline version: v0.9.2\nPipeline API versions: v1alpha1\n", got)
 
 }
 
@@ -0,0 +1,217 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ProjectFile is the name of the per-project configuration file, it is
+	// looked up in the current directory and its parents
+	ProjectFile = ".tkn.yaml"
+
+	envPrefix = "TKN_"
+)
+
+// Config holds the defaults of tkn, all the fields are optional
+type Config struct {
+	Namespace      string            `json:"namespace,omitempty"`
+	Context        string            `json:"context,omitempty"`
+	Output         string            `json:"output,omitempty"`
+	Colour         *bool             `json:"colour,omitempty"`
+	ServiceAccount string            `json:"serviceaccount,omitempty"`
+	ShowLog        *bool             `json:"showlog,omitempty"`
+	Timestamps     *bool             `json:"timestamps,omitempty"`
+	Aliases        map[string]string `json:"aliases,omitempty"`
+}
+
+// UserFile returns the path of the user configuration file,
+// $XDG_CONFIG_HOME/tkn/config.yaml or ~/.config/tkn/config.yaml
+func UserFile() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "tkn", "config.yaml"), nil
+}
+
+// ProjectFilePath returns the path of the closest .tkn.yaml from the
+// current directory, or an empty string if there is none
+func ProjectFilePath() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		path := filepath.Join(dir, ProjectFile)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// Load returns the configuration resolved from the TKN_* environment
+// variables, the project file and the user file, in that order of precedence
+func Load() (*Config, error) {
+	cfg := &Config{}
+
+	user, err := UserFile()
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.mergeFile(user); err != nil {
+		return nil, err
+	}
+
+	project, err := ProjectFilePath()
+	if err != nil {
+		return nil, err
+	}
+	if project != "" {
+		if err := cfg.mergeFile(project); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.mergeEnv(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Read returns the configuration of a single file, a missing file is an
+// empty configuration
+func Read(path string) (*Config, error) {
+	cfg := &Config{}
+
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := yaml.UnmarshalStrict(b, cfg); err != nil {
+		return nil, fmt.Errorf("invalid configuration file %s: %v", path, err)
+	}
+	return cfg, nil
+}
+
+// Write saves the configuration in path, creating its directory if needed
+func Write(path string, cfg *Config) error {
+	b, err := yaml.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, b, 0644)
+}
+
+func (c *Config) mergeFile(path string) error {
+	f, err := Read(path)
+	if err != nil {
+		return err
+	}
+	c.merge(f)
+	return nil
+}
+
+// merge overrides the fields of c which are set in o
+func (c *Config) merge(o *Config) {
+	if o.Namespace != "" {
+		c.Namespace = o.Namespace
+	}
+	if o.Context != "" {
+		c.Context = o.Context
+	}
+	if o.Output != "" {
+		c.Output = o.Output
+	}
+	if o.Colour != nil {
+		c.Colour = o.Colour
+	}
+	if o.ServiceAccount != "" {
+		c.ServiceAccount = o.ServiceAccount
+	}
+	if o.ShowLog != nil {
+		c.ShowLog = o.ShowLog
+	}
+	if o.Timestamps != nil {
+		c.Timestamps = o.Timestamps
+	}
+	for name, command := range o.Aliases {
+		if c.Aliases == nil {
+			c.Aliases = map[string]string{}
+		}
+		c.Aliases[name] = command
+	}
+}
+
+func (c *Config) mergeEnv() error {
+	env := &Config{
+		Namespace:      os.Getenv(envPrefix + "NAMESPACE"),
+		Context:        os.Getenv(envPrefix + "CONTEXT"),
+		Output:         os.Getenv(envPrefix + "OUTPUT"),
+		ServiceAccount: os.Getenv(envPrefix + "SERVICEACCOUNT"),
+	}
+
+	var err error
+	if env.Colour, err = envBool("COLOUR"); err != nil {
+		return err
+	}
+	if env.ShowLog, err = envBool("SHOWLOG"); err != nil {
+		return err
+	}
+	if env.Timestamps, err = envBool("TIMESTAMPS"); err != nil {
+		return err
+	}
+
+	c.merge(env)
+	return nil
+}
+
+func envBool(name string) (*bool, error) {
+	v := os.Getenv(envPrefix + name)
+	if v == "" {
+		return nil, nil
+	}
+
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value %q for %s%s, must be true or false", v, envPrefix, name)
+	}
+	return &b, nil
+}
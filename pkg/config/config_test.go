@@ -0,0 +1,129 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+)
+
+func TestLoad(t *testing.T) {
+	home, err := ioutil.TempDir("", "tkn-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(home)
+
+	writeFile(t, filepath.Join(home, "tkn", "config.yaml"), `namespace: user
+context: user
+output: yaml
+colour: false
+serviceaccount: builder
+aliases:
+  prl: pipelinerun list
+  st: pipeline start
+`)
+	project := filepath.Join(home, "project")
+	writeFile(t, filepath.Join(project, ProjectFile), `namespace: project
+showlog: false
+aliases:
+  st: pipeline start --showlog
+`)
+	dir := filepath.Join(project, "sub", "dir")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	wd, _ := os.Getwd()
+	defer os.Chdir(wd)
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	setEnv(t, "XDG_CONFIG_HOME", home)
+	setEnv(t, "TKN_CONTEXT", "env")
+	setEnv(t, "TKN_TIMESTAMPS", "true")
+	defer func() {
+		for _, env := range []string{"XDG_CONFIG_HOME", "TKN_CONTEXT", "TKN_TIMESTAMPS"} {
+			os.Unsetenv(env)
+		}
+	}()
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	test.AssertOutput(t, "project", cfg.Namespace)
+	test.AssertOutput(t, "env", cfg.Context)
+	test.AssertOutput(t, "yaml", cfg.Output)
+	test.AssertOutput(t, "builder", cfg.ServiceAccount)
+	test.AssertOutput(t, false, *cfg.Colour)
+	test.AssertOutput(t, false, *cfg.ShowLog)
+	test.AssertOutput(t, true, *cfg.Timestamps)
+	test.AssertOutput(t, map[string]string{
+		"prl": "pipelinerun list",
+		"st":  "pipeline start --showlog",
+	}, cfg.Aliases)
+
+	setEnv(t, "TKN_SHOWLOG", "maybe")
+	defer os.Unsetenv("TKN_SHOWLOG")
+	_, err = Load()
+	if err == nil {
+		t.Fatal("error expected for an invalid TKN_SHOWLOG")
+	}
+	test.AssertOutput(t, `invalid value "maybe" for TKN_SHOWLOG, must be true or false`, err.Error())
+}
+
+func TestRead(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tkn-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg, err := Read(filepath.Join(dir, "missing.yaml"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	test.AssertOutput(t, &Config{}, cfg)
+
+	path := filepath.Join(dir, "config.yaml")
+	writeFile(t, path, "namespaces: foo\n")
+	if _, err := Read(path); err == nil {
+		t.Fatal("error expected for an unknown field")
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func setEnv(t *testing.T, name, value string) {
+	t.Helper()
+	if err := os.Setenv(name, value); err != nil {
+		t.Fatal(err)
+	}
+}
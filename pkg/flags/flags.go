@@ -15,10 +15,15 @@
 package flags
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/tektoncd/cli/pkg/cli"
-	"github.com/tektoncd/cli/pkg/cmd/completion"
+	"github.com/tektoncd/cli/pkg/config"
+	"github.com/tektoncd/cli/pkg/printer"
 )
 
 const (
@@ -26,6 +31,7 @@ const (
 	context    = "context"
 	namespace  = "namespace"
 	nocolour   = "nocolour"
+	apiVersion = "api-version"
 )
 
 // AddTektonOptions amends command to add flags required to initialise a cli.Param
@@ -46,15 +52,14 @@ func AddTektonOptions(cmd *cobra.Command) {
 		nocolour, "C", false,
 		"disable colouring (default: false)")
 
-	// Add custom completion for that command as specified in
-	// bashCompletionFlags map
-	for name, completion := range completion.ShellCompletionMap {
-		pflag := cmd.PersistentFlags().Lookup(name)
-		AddShellCompletion(pflag, completion)
-	}
+	cmd.PersistentFlags().String(
+		apiVersion, "",
+		"version of the tekton.dev API to use, one of: "+strings.Join(cli.APIVersions, ", ")+", only "+strings.Join(cli.SupportedAPIVersions, ", ")+" is supported yet (default: the version served by the cluster)")
 }
 
-// InitParams initialises cli.Params based on flags defined in command
+// InitParams initialises cli.Params based on flags defined in command, the
+// flags which are not set default to the values of the configuration
+// files and TKN_* environment variables
 func InitParams(p cli.Params, cmd *cobra.Command) error {
 	// NOTE: breaks symmetry with AddTektonOptions as this uses Flags instead of
 	// PersistentFlags as it could be the sub command that is trying to access
@@ -66,12 +71,29 @@ func InitParams(p cli.Params, cmd *cobra.Command) error {
 	}
 	p.SetKubeConfigPath(kcPath)
 
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
 	kContext, err := cmd.Flags().GetString(context)
 	if err != nil {
 		return err
 	}
+	if kContext == "" {
+		kContext = cfg.Context
+	}
 	p.SetKubeContext(kContext)
 
+	version, err := cmd.Flags().GetString(apiVersion)
+	if err != nil {
+		return err
+	}
+	if err := cli.ValidateAPIVersion(version); err != nil {
+		return err
+	}
+	p.SetAPIVersion(version)
+
 	// ensure that the config is valid by creating a client
 	if _, err := p.Clients(); err != nil {
 		return err
@@ -81,6 +103,9 @@ func InitParams(p cli.Params, cmd *cobra.Command) error {
 	if err != nil {
 		return err
 	}
+	if ns == "" {
+		ns = cfg.Namespace
+	}
 	if ns != "" {
 		p.SetNamespace(ns)
 	}
@@ -89,15 +114,54 @@ func InitParams(p cli.Params, cmd *cobra.Command) error {
 	if err != nil {
 		return err
 	}
+	if !cmd.Flags().Changed(nocolour) && cfg.Colour != nil {
+		nocolourFlag = !*cfg.Colour
+	}
 	p.SetNoColour(nocolourFlag)
 
-	return nil
+	return applyDefaults(cmd, cfg)
 }
 
 // AddShellCompletion add a hint to the cobra flag annotation for how to do a completion
+//
+// Deprecated: the flags of tkn are completed by the completion package, see
+// completion.RegisterFlag
 func AddShellCompletion(pflag *pflag.Flag, shellfunction string) {
 	if pflag.Annotations == nil {
 		pflag.Annotations = map[string][]string{}
 	}
 	pflag.Annotations[cobra.BashCompCustom] = append(pflag.Annotations[cobra.BashCompCustom], shellfunction)
 }
+
+// applyDefaults sets the flags of the command which are not set on the
+// command line to their value in the configuration
+func applyDefaults(cmd *cobra.Command, cfg *config.Config) error {
+	defaults := map[string]string{
+		"serviceaccount": cfg.ServiceAccount,
+		"showlog":        formatBool(cfg.ShowLog),
+		"timestamps":     formatBool(cfg.Timestamps),
+	}
+	// only the list commands, printing a table by default, get the output
+	// format, and only when it is one they accept
+	if f := cmd.Flags().Lookup("output"); f != nil && printer.AcceptsFormat(f, cfg.Output) {
+		defaults["output"] = cfg.Output
+	}
+
+	for name, value := range defaults {
+		f := cmd.Flags().Lookup(name)
+		if f == nil || f.Changed || value == "" {
+			continue
+		}
+		if err := f.Value.Set(value); err != nil {
+			return fmt.Errorf("invalid default %q for --%s: %v", value, name, err)
+		}
+	}
+	return nil
+}
+
+func formatBool(b *bool) string {
+	if b == nil {
+		return ""
+	}
+	return strconv.FormatBool(*b)
+}
@@ -15,12 +15,18 @@
 package flags
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/printer"
+	"github.com/tektoncd/cli/pkg/test"
+	cliopts "k8s.io/cli-runtime/pkg/genericclioptions"
 )
 
 func TestFlags_add_shell_completion(t *testing.T) {
@@ -53,3 +59,120 @@ func TestFlags_colouring(t *testing.T) {
 	assert.True(t, color.NoColor)
 
 }
+
+func TestFlags_config_defaults(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tkn-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := os.MkdirAll(filepath.Join(dir, "tkn"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	config := "namespace: ns\noutput: yaml\nserviceaccount: builder\nshowlog: false\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "tkn", "config.yaml"), []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv("XDG_CONFIG_HOME", dir)
+	defer os.Unsetenv("XDG_CONFIG_HOME")
+
+	newCommand := func(args ...string) (*cobra.Command, *string, *string, *bool) {
+		var sa string
+		var showlog bool
+		cmd := &cobra.Command{}
+		AddTektonOptions(cmd)
+		f := cliopts.NewPrintFlags("list")
+		printer.AddListFlags(cmd, f)
+		cmd.Flags().StringVarP(&sa, "serviceaccount", "s", "", "")
+		cmd.Flags().BoolVarP(&showlog, "showlog", "", true, "")
+		if err := cmd.ParseFlags(args); err != nil {
+			t.Fatal(err)
+		}
+		return cmd, f.OutputFormat, &sa, &showlog
+	}
+
+	p := &test.Params{}
+	cmd, output, sa, showlog := newCommand()
+	if err := InitParams(p, cmd); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, "ns", p.Namespace())
+	assert.Equal(t, "yaml", *output)
+	assert.Equal(t, "builder", *sa)
+	assert.False(t, *showlog)
+
+	os.Setenv("TKN_NAMESPACE", "env")
+	defer os.Unsetenv("TKN_NAMESPACE")
+
+	p = &test.Params{}
+	cmd, output, sa, showlog = newCommand("-o", "json", "--showlog", "-s", "default")
+	if err := InitParams(p, cmd); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, "env", p.Namespace())
+	assert.Equal(t, "json", *output)
+	assert.Equal(t, "default", *sa)
+	assert.True(t, *showlog)
+
+	p = &test.Params{}
+	cmd, _, _, _ = newCommand("-n", "flag")
+	if err := InitParams(p, cmd); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, "flag", p.Namespace())
+
+	// the format is not applied to the commands not accepting it
+	os.Setenv("TKN_OUTPUT", "wide")
+	defer os.Unsetenv("TKN_OUTPUT")
+
+	p = &test.Params{}
+	cmd, output, _, _ = newCommand()
+	if err := InitParams(p, cmd); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, "", *output)
+
+	p = &test.Params{}
+	cmd = &cobra.Command{}
+	AddTektonOptions(cmd)
+	f := cliopts.NewPrintFlags("describe")
+	f.AddFlags(cmd)
+	if err := cmd.ParseFlags(nil); err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv("TKN_OUTPUT", "yaml")
+	if err := InitParams(p, cmd); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, "", *f.OutputFormat)
+}
+
+func TestFlags_api_version(t *testing.T) {
+	newCommand := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{}
+		AddTektonOptions(cmd)
+		if err := cmd.ParseFlags(args); err != nil {
+			t.Fatal(err)
+		}
+		return cmd
+	}
+
+	p := &test.Params{}
+	err := InitParams(p, newCommand("--api-version", "v1beta1"))
+	assert.EqualError(t, err, "unknown API version v1beta1, must be one of: v1alpha1, v1alpha2")
+
+	p = &test.Params{}
+	if err := InitParams(p, newCommand("--api-version", "v1alpha1")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cs, err := p.Clients()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, "v1alpha1", cs.APIVersion)
+
+	p = &test.Params{}
+	err = InitParams(p, newCommand("--api-version", "v1alpha2"))
+	assert.EqualError(t, err, "tekton.dev/v1alpha2 is not supported by this version of tkn, which only handles the tekton.dev/v1alpha1 objects")
+}
@@ -0,0 +1,188 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	"github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+const none = "---"
+
+// Fields are the values of the fields of two runs, in the order they were
+// first set
+type Fields struct {
+	names  []string
+	values map[string]*[2]string
+}
+
+func NewFields() *Fields {
+	return &Fields{values: map[string]*[2]string{}}
+}
+
+// Set sets the value of a field of the first or second run, i.e. run 0 or 1
+func (f *Fields) Set(run int, name, value string) {
+	v, ok := f.values[name]
+	if !ok {
+		v = &[2]string{none, none}
+		f.values[name] = v
+		f.names = append(f.names, name)
+	}
+	if value != "" {
+		v[run] = value
+	}
+}
+
+// Print prints the fields side by side, only the ones which differ unless
+// all are printed
+func (f *Fields) Print(out io.Writer, runs [2]string, all bool) error {
+	names := []string{}
+	for _, name := range f.names {
+		if v := f.values[name]; all || v[0] != v[1] {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		fmt.Fprintln(out, "No differences")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	fmt.Fprintf(w, "FIELD\t%s\t%s\n", runs[0], runs[1])
+	for _, name := range names {
+		v := f.values[name]
+		fmt.Fprintf(w, "%s\t%s\t%s\n", name, v[0], v[1])
+	}
+	return w.Flush()
+}
+
+// SetParams sets the values of the params
+func (f *Fields) SetParams(run int, prefix string, params []v1alpha1.Param) {
+	for _, p := range params {
+		f.Set(run, prefix+"param "+p.Name, paramValue(p.Value))
+	}
+}
+
+// SetResource sets the name of the resource bound to a run, along with the
+// params of the resource it refers to or embeds, e.g. the revision of a git
+// resource. The params of a resource which is not found are left unset.
+func (f *Fields) SetResource(run int, tekton versioned.Interface, ns, prefix string, b v1alpha1.PipelineResourceBinding) error {
+	name := prefix + "resource " + b.Name
+
+	var spec *v1alpha1.PipelineResourceSpec
+	switch {
+	case b.ResourceRef != nil:
+		f.Set(run, name, b.ResourceRef.Name)
+		r, err := tekton.TektonV1alpha1().PipelineResources(ns).Get(b.ResourceRef.Name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get pipelineresource %s: %s", b.ResourceRef.Name, err)
+		}
+		spec = &r.Spec
+	case b.ResourceSpec != nil:
+		f.Set(run, name, "(embedded)")
+		spec = b.ResourceSpec
+	default:
+		return nil
+	}
+
+	for _, p := range spec.Params {
+		f.Set(run, name+" "+p.Name, p.Value)
+	}
+	return nil
+}
+
+// SetTaskRunStatus sets the status and duration of a taskrun, the images of
+// its steps along with their IDs, which contain the digests, and the results
+// of its resources, e.g. the commits and digests it resolved
+func (f *Fields) SetTaskRunStatus(run int, prefix string, status *v1alpha1.TaskRunStatus, images map[string]string) {
+	f.Set(run, prefix+"status", formatted.Condition(status.Conditions))
+	f.Set(run, prefix+"duration", formatted.Duration(status.StartTime, status.CompletionTime))
+	for _, s := range status.Steps {
+		f.Set(run, prefix+"step "+s.Name+" status", stepStatus(s))
+		f.Set(run, prefix+"step "+s.Name+" image", images[s.Name])
+		f.Set(run, prefix+"step "+s.Name+" image ID", s.ImageID)
+	}
+	for _, r := range status.ResourcesResult {
+		name, key := r.ResourceRef.Name, r.Key
+		if name == "" {
+			name = r.Name
+		}
+		if key == "" && r.Digest != "" {
+			key, r.Value = "digest", r.Digest
+		}
+		f.Set(run, prefix+"result "+name+" "+key, r.Value)
+	}
+}
+
+// StepImages returns the images of the steps of a taskrun by name, as set on
+// the containers of its pod, or in the spec it embeds once the pod is gone
+func StepImages(kube k8s.Interface, ns string, status *v1alpha1.TaskRunStatus, spec *v1alpha1.TaskSpec) map[string]string {
+	images := map[string]string{}
+
+	if status.PodName != "" {
+		pod, err := kube.CoreV1().Pods(ns).Get(status.PodName, metav1.GetOptions{})
+		if err == nil {
+			containers := map[string]string{}
+			for _, c := range pod.Spec.Containers {
+				containers[c.Name] = c.Image
+			}
+			for _, s := range status.Steps {
+				container := s.ContainerName
+				if container == "" {
+					container = "step-" + s.Name
+				}
+				images[s.Name] = containers[container]
+			}
+			return images
+		}
+	}
+
+	if spec != nil {
+		for _, s := range spec.Steps {
+			images[s.Name] = s.Image
+		}
+	}
+	return images
+}
+
+func stepStatus(s v1alpha1.StepState) string {
+	switch {
+	case s.Terminated != nil:
+		return s.Terminated.Reason
+	case s.Running != nil:
+		return "Running"
+	case s.Waiting != nil:
+		return s.Waiting.Reason
+	}
+	return ""
+}
+
+func paramValue(v v1alpha1.ArrayOrString) string {
+	if v.Type == v1alpha1.ParamTypeArray {
+		return fmt.Sprint(v.ArrayVal)
+	}
+	return v.StringVal
+}
@@ -0,0 +1,67 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+)
+
+func TestFields_Print(t *testing.T) {
+	f := NewFields()
+	f.Set(0, "status", "Succeeded")
+	f.Set(0, "param revision", "main")
+	f.Set(0, "service account", "builder")
+	f.Set(1, "status", "Failed")
+	f.Set(1, "service account", "builder")
+	f.Set(1, "param image", "app:1")
+
+	out := &strings.Builder{}
+	if err := f.Print(out, [2]string{"good", "bad"}, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `FIELD            good        bad
+status           Succeeded   Failed
+param revision   main        ---
+param image      ---         app:1
+`
+	test.AssertOutput(t, expected, out.String())
+
+	out = &strings.Builder{}
+	if err := f.Print(out, [2]string{"good", "bad"}, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = `FIELD             good        bad
+status            Succeeded   Failed
+param revision    main        ---
+service account   builder     builder
+param image       ---         app:1
+`
+	test.AssertOutput(t, expected, out.String())
+}
+
+func TestFields_Print_no_differences(t *testing.T) {
+	f := NewFields()
+	f.Set(0, "status", "Succeeded")
+	f.Set(1, "status", "Succeeded")
+
+	out := &strings.Builder{}
+	if err := f.Print(out, [2]string{"one", "two"}, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	test.AssertOutput(t, "No differences\n", out.String())
+}
@@ -0,0 +1,113 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// CancelOptions select the pipelineruns or taskruns to cancel, either by
+// their name or every running one matching the flags
+type CancelOptions struct {
+	Resource    string
+	ForceCancel bool
+	AllRunning  bool
+	Selector    string
+	// Owner is the name of the OwnerKind, i.e. pipeline or task, whose
+	// runs are cancelled. The runs are labelled with OwnerLabel.
+	Owner      string
+	OwnerKind  string
+	OwnerLabel string
+}
+
+// AddFlags adds the flags selecting the runs to cancel, the flag of the
+// owner of the runs is named after its kind
+func (o *CancelOptions) AddFlags(c *cobra.Command) {
+	c.Flags().BoolVarP(&o.ForceCancel, "force", "f", false, "Whether to cancel the selected runs without confirmation (default: false)")
+	c.Flags().BoolVarP(&o.AllRunning, "all-running", "", false, fmt.Sprintf("cancel all the running %ss of the namespace", o.Resource))
+	c.Flags().StringVarP(&o.Selector, "selector", "l", "", fmt.Sprintf("cancel the running %ss matching the selector (label query), supports '=', '==', and '!='", o.Resource))
+	c.Flags().StringVarP(&o.Owner, o.OwnerKind, "", "", fmt.Sprintf("cancel the running %ss of the %s", o.Resource, o.OwnerKind))
+}
+
+// Bulk returns whether the running runs are selected by the flags
+func (o *CancelOptions) Bulk() bool {
+	return o.AllRunning || o.Selector != "" || o.Owner != ""
+}
+
+// Validate checks that runs are either selected by their name or by the
+// flags, and the selector
+func (o *CancelOptions) Validate(names []string) error {
+	if len(names) == 0 && !o.Bulk() {
+		return fmt.Errorf("a %s name or one of --all-running, --selector or --%s is required", o.Resource, o.OwnerKind)
+	}
+	if len(names) > 0 && o.Bulk() {
+		return fmt.Errorf("--all-running, --selector and --%s can not be used with a %s name", o.OwnerKind, o.Resource)
+	}
+
+	if o.Selector != "" {
+		if _, err := labels.Parse(o.Selector); err != nil {
+			return fmt.Errorf("invalid selector %q: %v", o.Selector, err)
+		}
+	}
+	return nil
+}
+
+// ListOptions returns the options listing the runs selected by the flags
+func (o *CancelOptions) ListOptions() metav1.ListOptions {
+	selectors := []string{}
+	if o.Selector != "" {
+		selectors = append(selectors, o.Selector)
+	}
+	if o.Owner != "" {
+		selectors = append(selectors, fmt.Sprintf("%s=%s", o.OwnerLabel, o.Owner))
+	}
+	return metav1.ListOptions{LabelSelector: strings.Join(selectors, ",")}
+}
+
+// CheckOptions prints the runs about to be cancelled and asks for a single
+// confirmation for all of them, unless cancelling them is forced. Nothing is
+// cancelled without an answer.
+func (o *CancelOptions) CheckOptions(s *cli.Stream, names []string) error {
+	fmt.Fprintf(s.Out, "The following %ss will be cancelled:\n", o.Resource)
+	for _, name := range names {
+		fmt.Fprintf(s.Out, "  %s\n", name)
+	}
+
+	if o.ForceCancel {
+		return nil
+	}
+
+	yes, answered := confirm(s, fmt.Sprintf("Are you sure you want to cancel %d %s(s)", len(names), o.Resource))
+	if !answered {
+		return fmt.Errorf("no confirmation to cancel %d %s(s), use --force to cancel them without confirmation", len(names), o.Resource)
+	}
+	if !yes {
+		return fmt.Errorf("canceled cancelling %ss", o.Resource)
+	}
+	return nil
+}
+
+// CancelEach cancels the runs one after the other, reporting the ones which
+// could not be cancelled without stopping. The error of the run is returned
+// when only one is cancelled, a summary of the failures otherwise.
+func (o *CancelOptions) CancelEach(s *cli.Stream, names []string, cancel func(name string) error) error {
+	return each(s, "cancel", o.Resource, names, cancel)
+}
@@ -0,0 +1,122 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/test"
+)
+
+func TestCancelOptions_Validate(t *testing.T) {
+	testParams := []struct {
+		name    string
+		opt     *CancelOptions
+		names   []string
+		wantErr string
+	}{
+		{
+			name:  "name",
+			opt:   &CancelOptions{Resource: "pipelinerun", OwnerKind: "pipeline"},
+			names: []string{"run"},
+		},
+		{
+			name: "all running",
+			opt:  &CancelOptions{Resource: "pipelinerun", OwnerKind: "pipeline", AllRunning: true},
+		},
+		{
+			name:    "nothing selected",
+			opt:     &CancelOptions{Resource: "pipelinerun", OwnerKind: "pipeline"},
+			wantErr: "a pipelinerun name or one of --all-running, --selector or --pipeline is required",
+		},
+		{
+			name:    "name and owner",
+			opt:     &CancelOptions{Resource: "pipelinerun", OwnerKind: "pipeline", Owner: "build"},
+			names:   []string{"run"},
+			wantErr: "--all-running, --selector and --pipeline can not be used with a pipelinerun name",
+		},
+		{
+			name:    "invalid selector",
+			opt:     &CancelOptions{Resource: "taskrun", OwnerKind: "task", Selector: "a=b=c"},
+			wantErr: `invalid selector "a=b=c": found '=', expected: ',' or 'end of string'`,
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			err := tp.opt.Validate(tp.names)
+			if tp.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("error expected here")
+			}
+			test.AssertOutput(t, tp.wantErr, err.Error())
+		})
+	}
+}
+
+func TestCancelOptions_ListOptions(t *testing.T) {
+	opt := &CancelOptions{Selector: "app=web", Owner: "build", OwnerLabel: "tekton.dev/pipeline"}
+	test.AssertOutput(t, "app=web,tekton.dev/pipeline=build", opt.ListOptions().LabelSelector)
+
+	opt = &CancelOptions{AllRunning: true}
+	test.AssertOutput(t, "", opt.ListOptions().LabelSelector)
+}
+
+func TestCancelOptions_CheckOptions(t *testing.T) {
+	opt := &CancelOptions{Resource: "taskrun"}
+
+	out := &strings.Builder{}
+	err := opt.CheckOptions(&cli.Stream{In: strings.NewReader("n"), Out: out}, []string{"tr-1", "tr-2"})
+	if err == nil {
+		t.Fatal("error expected here")
+	}
+	test.AssertOutput(t, "canceled cancelling taskruns", err.Error())
+	test.AssertOutput(t, "The following taskruns will be cancelled:\n  tr-1\n  tr-2\nAre you sure you want to cancel 2 taskrun(s) (y/n): ", out.String())
+}
+
+func TestCancelOptions_CheckOptions_no_answer(t *testing.T) {
+	opt := &CancelOptions{Resource: "taskrun"}
+
+	err := opt.CheckOptions(&cli.Stream{In: strings.NewReader(""), Out: &strings.Builder{}}, []string{"tr-1", "tr-2"})
+	if err == nil {
+		t.Fatal("error expected here")
+	}
+	test.AssertOutput(t, "no confirmation to cancel 2 taskrun(s), use --force to cancel them without confirmation", err.Error())
+}
+
+func TestCancelOptions_CancelEach(t *testing.T) {
+	opt := &CancelOptions{Resource: "taskrun"}
+
+	errOut := &strings.Builder{}
+	err := opt.CancelEach(&cli.Stream{Err: errOut}, []string{"tr-1", "tr-2", "tr-3"}, func(name string) error {
+		if name == "tr-2" {
+			return fmt.Errorf("failed to cancel taskrun %q", name)
+		}
+		return nil
+	})
+	if err == nil {
+		t.Fatal("error expected here")
+	}
+	test.AssertOutput(t, "failed to cancel 1 of 3 taskruns", err.Error())
+	test.AssertOutput(t, "Error: failed to cancel taskrun \"tr-2\"\n", errOut.String())
+}
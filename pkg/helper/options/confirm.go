@@ -0,0 +1,64 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/tektoncd/cli/pkg/cli"
+)
+
+// confirm prints the question and reads the answers until one is y or n. It
+// returns whether the answer is y, and whether there was an answer at all
+// before the end of the input, e.g. when it is not a terminal.
+func confirm(s *cli.Stream, question string) (yes bool, answered bool) {
+	fmt.Fprintf(s.Out, "%s (y/n): ", question)
+
+	scanner := bufio.NewScanner(s.In)
+	for scanner.Scan() {
+		switch strings.TrimSpace(scanner.Text()) {
+		case "y":
+			return true, true
+		case "n":
+			return false, true
+		}
+		fmt.Fprint(s.Out, "Please enter (y/n): ")
+	}
+	return false, false
+}
+
+// each calls apply with the names one after the other, reporting the ones it
+// fails for without stopping. The error of the name is returned when there is
+// only one, a summary of the failures to verb the resources otherwise.
+func each(s *cli.Stream, verb, resource string, names []string, apply func(name string) error) error {
+	if len(names) == 1 {
+		return apply(names[0])
+	}
+
+	failed := 0
+	for _, name := range names {
+		if err := apply(name); err != nil {
+			fmt.Fprintf(s.Err, "Error: %s\n", err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to %s %d of %d %ss", verb, failed, len(names), resource)
+	}
+	return nil
+}
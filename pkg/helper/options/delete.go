@@ -15,40 +15,285 @@
 package options
 
 import (
-	"bufio"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"knative.dev/pkg/apis/duck/v1beta1"
+)
+
+const (
+	CascadeForeground = "foreground"
+	CascadeBackground = "background"
+	CascadeOrphan     = "orphan"
+
+	// deleteWaitTimeout is how long --wait waits for a resource to be gone
+	deleteWaitTimeout = 5 * time.Minute
+)
+
+var (
+	cascades = []string{CascadeForeground, CascadeBackground, CascadeOrphan}
+
+	propagationPolicies = map[string]metav1.DeletionPropagation{
+		CascadeForeground: metav1.DeletePropagationForeground,
+		CascadeBackground: metav1.DeletePropagationBackground,
+		CascadeOrphan:     metav1.DeletePropagationOrphan,
+	}
 )
 
 type DeleteOptions struct {
 	Resource    string
 	ForceDelete bool
 	DeleteAll   bool
+	DryRun      bool
+	Cascade     string
+	Wait        bool
+	// Dependents lists the pipelines or tasks still using each of the
+	// resources to delete, e.g. pipeline/ci, which are only deleted with
+	// --force
+	Dependents map[string][]string
+}
+
+// AddCascadeFlags adds the flags setting how the dependents of the deleted
+// resources, e.g. the pods of taskruns, are deleted
+func (o *DeleteOptions) AddCascadeFlags(c *cobra.Command) {
+	c.Flags().StringVarP(&o.Cascade, "cascade", "", CascadeBackground, "how the dependents of the deleted resources are deleted, one of: "+strings.Join(cascades, "|"))
+	c.Flags().BoolVarP(&o.Wait, "wait", "", false, "wait for the resources to be deleted, along with their dependents unless orphaned")
 }
 
-func (o *DeleteOptions) CheckOptions(s *cli.Stream, resourceName string) error {
+// ValidateCascade checks the value of --cascade
+func (o *DeleteOptions) ValidateCascade() error {
+	if o.Cascade != "" && !contains(cascades, o.Cascade) {
+		return fmt.Errorf("invalid cascade %q, must be one of: %s", o.Cascade, strings.Join(cascades, "|"))
+	}
+	return nil
+}
+
+// KubeDeleteOptions returns the options of the delete requests. Waiting for
+// the resources to be deleted waits for their dependents too, unless they
+// are orphaned.
+func (o *DeleteOptions) KubeDeleteOptions() *metav1.DeleteOptions {
+	cascade := o.Cascade
+	if o.Wait && cascade != CascadeOrphan {
+		cascade = CascadeForeground
+	}
+
+	policy, ok := propagationPolicies[cascade]
+	if !ok {
+		return &metav1.DeleteOptions{}
+	}
+	return &metav1.DeleteOptions{PropagationPolicy: &policy}
+}
+
+// WaitDeleted waits with --wait for a deleted resource to be gone, i.e. for
+// get to return a not found error
+func (o *DeleteOptions) WaitDeleted(get func() error) error {
+	if !o.Wait {
+		return nil
+	}
+
+	return wait.PollImmediate(time.Second, deleteWaitTimeout, func() (bool, error) {
+		err := get()
+		if errors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	})
+}
+
+// DeleteEach deletes the resources one after the other, reporting the ones
+// which could not be deleted without stopping. The error of the resource is
+// returned when only one is deleted, a summary of the failures otherwise.
+func (o *DeleteOptions) DeleteEach(s *cli.Stream, resourceNames []string, del func(name string) error) error {
+	return each(s, "delete", o.Resource, resourceNames, del)
+}
+
+// CheckOptions asks for a single confirmation to delete the resources,
+// unless deleting them is forced. Resources with dependents are listed and
+// are never deleted without being forced.
+func (o *DeleteOptions) CheckOptions(s *cli.Stream, resourceNames ...string) error {
+	if err := o.checkDependents(s, resourceNames); err != nil {
+		return err
+	}
+
 	if o.ForceDelete {
 		return nil
 	}
 
+	resource, names := o.Resource, quote(resourceNames)
+	if len(resourceNames) > 1 {
+		resource += "s"
+	}
+
+	question := fmt.Sprintf("Are you sure you want to delete %s %s", resource, names)
 	if o.DeleteAll {
-		fmt.Fprintf(s.Out, "Are you sure you want to delete %s and related resources %q (y/n): ", o.Resource, resourceName)
-	} else {
-		fmt.Fprintf(s.Out, "Are you sure you want to delete %s %q (y/n): ", o.Resource, resourceName)
+		question = fmt.Sprintf("Are you sure you want to delete %s and related resources %s", resource, names)
 	}
 
-	scanner := bufio.NewScanner(s.In)
-	for scanner.Scan() {
-		t := strings.TrimSpace(scanner.Text())
-		if t == "y" {
-			break
-		} else if t == "n" {
-			return fmt.Errorf("canceled deleting %s %q", o.Resource, resourceName)
+	// without an answer the resources are deleted, as they always were
+	if yes, answered := confirm(s, question); answered && !yes {
+		return fmt.Errorf("canceled deleting %s %s", resource, names)
+	}
+	return nil
+}
+
+func (o *DeleteOptions) checkDependents(s *cli.Stream, resourceNames []string) error {
+	used := []string{}
+	for _, name := range resourceNames {
+		deps := o.Dependents[name]
+		if len(deps) == 0 {
+			continue
 		}
-		fmt.Fprint(s.Out, "Please enter (y/n): ")
+		fmt.Fprintf(s.Out, "%s %q is used by: %s\n", o.Resource, name, strings.Join(deps, ", "))
+		used = append(used, name)
+	}
+
+	if len(used) == 0 || o.ForceDelete {
+		return nil
+	}
+
+	resource := o.Resource
+	if len(used) > 1 {
+		resource += "s"
+	}
+	return fmt.Errorf("refusing to delete %s %s still in use, use --force to delete anyway", resource, quote(used))
+}
+
+func quote(names []string) string {
+	quoted := []string{}
+	for _, n := range names {
+		quoted = append(quoted, strconv.Quote(n))
 	}
+	return strings.Join(quoted, ", ")
+}
 
+// CheckBulkOptions prints the resources about to be deleted and asks for a
+// single confirmation for all of them, unless deleting them is forced or a
+// dry run. Nothing is deleted without an answer, e.g. when the input is not
+// a terminal.
+func (o *DeleteOptions) CheckBulkOptions(s *cli.Stream, resourceNames []string) error {
+	if o.DryRun {
+		fmt.Fprintf(s.Out, "The following %ss would be deleted:\n", o.Resource)
+	} else {
+		fmt.Fprintf(s.Out, "The following %ss will be deleted:\n", o.Resource)
+	}
+	for _, name := range resourceNames {
+		fmt.Fprintf(s.Out, "  %s\n", name)
+	}
+
+	if o.ForceDelete || o.DryRun {
+		return nil
+	}
+
+	yes, answered := confirm(s, fmt.Sprintf("Are you sure you want to delete %d %s(s)", len(resourceNames), o.Resource))
+	if !answered {
+		return fmt.Errorf("no confirmation to delete %d %s(s), use --force to delete them without confirmation", len(resourceNames), o.Resource)
+	}
+	if !yes {
+		return fmt.Errorf("canceled deleting %ss", o.Resource)
+	}
 	return nil
 }
+
+// RunDeleteOptions select the pipelineruns or taskruns to delete in bulk,
+// when no name is given to the delete commands
+type RunDeleteOptions struct {
+	All       bool
+	Keep      int
+	OlderThan string
+	Status    string
+	Selector  string
+}
+
+// Run is a pipelinerun or taskrun the bulk deletion selects from
+type Run struct {
+	Name string
+	// Group is the pipeline or task the run belongs to, --keep keeps the
+	// newest runs of each
+	Group      string
+	Conditions v1beta1.Conditions
+	Created    time.Time
+}
+
+func (o *RunDeleteOptions) AddFlags(c *cobra.Command) {
+	c.Flags().BoolVarP(&o.All, "all", "", false, "delete all the runs of the namespace, or of the ones selected by the other flags")
+	c.Flags().IntVarP(&o.Keep, "keep", "", 0, "keep the newest N runs of each pipeline or task and delete the older ones")
+	c.Flags().StringVarP(&o.OlderThan, "older-than", "", "", "only delete the runs created more than a duration ago, e.g. 12h or 7d")
+	c.Flags().StringVarP(&o.Status, "status", "", "", "only delete the runs with the given status, one of: "+strings.Join(runStatuses, "|"))
+	c.Flags().StringVarP(&o.Selector, "selector", "l", "", "only delete the runs matching the selector (label query), supports '=', '==', and '!='")
+}
+
+// Bulk returns whether runs are selected to be deleted in bulk
+func (o *RunDeleteOptions) Bulk() bool {
+	return o.All || o.Keep > 0 || o.OlderThan != "" || o.Status != "" || o.Selector != ""
+}
+
+// Validate checks the values of the options, and that runs are either
+// selected by their names or in bulk
+func (o *RunDeleteOptions) Validate(resource string, names []string) error {
+	if len(names) == 0 && !o.Bulk() {
+		return fmt.Errorf("a %s name or one of --all, --keep, --older-than, --status or --selector is required", resource)
+	}
+	if len(names) > 0 && o.Bulk() {
+		return fmt.Errorf("--all, --keep, --older-than, --status and --selector can not be used with %s names", resource)
+	}
+
+	if o.Keep < 0 {
+		return fmt.Errorf("keep was %d but must be a positive number", o.Keep)
+	}
+	if o.OlderThan != "" {
+		if _, err := ParseDuration(o.OlderThan); err != nil {
+			return fmt.Errorf("invalid duration %q, must be like 12h or 7d", o.OlderThan)
+		}
+	}
+	if o.Status != "" && !contains(runStatuses, o.Status) {
+		return fmt.Errorf("invalid status %q, must be one of: %s", o.Status, strings.Join(runStatuses, "|"))
+	}
+	if o.Selector != "" {
+		if _, err := labels.Parse(o.Selector); err != nil {
+			return fmt.Errorf("invalid selector %q: %v", o.Selector, err)
+		}
+	}
+	return nil
+}
+
+// Select returns the names of the runs to delete, newest first. The newest
+// runs of each group are kept first, the others are then deleted if they
+// match the status and are old enough.
+func (o *RunDeleteOptions) Select(runs []Run, now time.Time) []string {
+	sorted := append([]Run{}, runs...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Created.After(sorted[j].Created)
+	})
+
+	var olderThan time.Time
+	if o.OlderThan != "" {
+		d, _ := ParseDuration(o.OlderThan)
+		olderThan = now.Add(-d)
+	}
+
+	kept := map[string]int{}
+	names := []string{}
+	for _, r := range sorted {
+		if kept[r.Group] < o.Keep {
+			kept[r.Group]++
+			continue
+		}
+		if o.Status != "" && RunStatus(r.Conditions) != o.Status {
+			continue
+		}
+		if o.OlderThan != "" && !r.Created.Before(olderThan) {
+			continue
+		}
+		names = append(names, r.Name)
+	}
+	return names
+}
@@ -15,12 +15,18 @@
 package options
 
 import (
+	"fmt"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/test"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/apis/duck/v1beta1"
 )
 
 func TestDeleteOptions(t *testing.T) {
@@ -89,3 +95,281 @@ func TestDeleteOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteOptions_CheckBulkOptions(t *testing.T) {
+	testParams := []struct {
+		name      string
+		opt       *DeleteOptions
+		input     string
+		wantError bool
+		want      string
+	}{
+		{
+			name:  "answer yes",
+			opt:   &DeleteOptions{Resource: "pipelinerun"},
+			input: "y",
+			want:  "The following pipelineruns will be deleted:\n  pr-1\n  pr-2\nAre you sure you want to delete 2 pipelinerun(s) (y/n): ",
+		},
+		{
+			name:      "answer no",
+			opt:       &DeleteOptions{Resource: "pipelinerun"},
+			input:     "n",
+			wantError: true,
+			want:      "canceled deleting pipelineruns",
+		},
+		{
+			name:      "no answer",
+			opt:       &DeleteOptions{Resource: "pipelinerun"},
+			input:     "maybe\n",
+			wantError: true,
+			want:      "no confirmation to delete 2 pipelinerun(s), use --force to delete them without confirmation",
+		},
+		{
+			name: "force",
+			opt:  &DeleteOptions{Resource: "pipelinerun", ForceDelete: true},
+			want: "The following pipelineruns will be deleted:\n  pr-1\n  pr-2\n",
+		},
+		{
+			name: "dry run",
+			opt:  &DeleteOptions{Resource: "pipelinerun", DryRun: true},
+			want: "The following pipelineruns would be deleted:\n  pr-1\n  pr-2\n",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			out := &strings.Builder{}
+			err := tp.opt.CheckBulkOptions(&cli.Stream{In: strings.NewReader(tp.input), Out: out}, []string{"pr-1", "pr-2"})
+			if tp.wantError {
+				if err == nil {
+					t.Fatal("error expected here")
+				}
+				test.AssertOutput(t, tp.want, err.Error())
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected Error: %v", err)
+			}
+			test.AssertOutput(t, tp.want, out.String())
+		})
+	}
+}
+
+func TestRunDeleteOptions_Validate(t *testing.T) {
+	testParams := []struct {
+		name  string
+		opt   *RunDeleteOptions
+		names []string
+		want  string
+	}{
+		{
+			name:  "name",
+			opt:   &RunDeleteOptions{},
+			names: []string{"pr-1"},
+		},
+		{
+			name: "bulk",
+			opt:  &RunDeleteOptions{Keep: 2, OlderThan: "7d", Status: StatusFailed, Selector: "app=web"},
+		},
+		{
+			name: "nothing selected",
+			opt:  &RunDeleteOptions{},
+			want: "a pipelinerun name or one of --all, --keep, --older-than, --status or --selector is required",
+		},
+		{
+			name:  "name and bulk",
+			opt:   &RunDeleteOptions{All: true},
+			names: []string{"pr-1"},
+			want:  "--all, --keep, --older-than, --status and --selector can not be used with pipelinerun names",
+		},
+		{
+			name: "invalid duration",
+			opt:  &RunDeleteOptions{OlderThan: "a week"},
+			want: "invalid duration \"a week\", must be like 12h or 7d",
+		},
+		{
+			name: "invalid status",
+			opt:  &RunDeleteOptions{Status: "done"},
+			want: "invalid status \"done\", must be one of: succeeded|failed|running|cancelled",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			err := tp.opt.Validate("pipelinerun", tp.names)
+			if tp.want == "" {
+				if err != nil {
+					t.Errorf("unexpected Error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("error expected here")
+			}
+			test.AssertOutput(t, tp.want, err.Error())
+		})
+	}
+}
+
+func TestRunDeleteOptions_Select(t *testing.T) {
+	now := time.Now()
+	failed := v1beta1.Conditions{{Type: apis.ConditionSucceeded, Status: corev1.ConditionFalse}}
+	succeeded := v1beta1.Conditions{{Type: apis.ConditionSucceeded, Status: corev1.ConditionTrue}}
+
+	runs := []Run{
+		{Name: "a-1", Group: "a", Conditions: failed, Created: now.Add(-10 * 24 * time.Hour)},
+		{Name: "a-2", Group: "a", Conditions: succeeded, Created: now.Add(-8 * 24 * time.Hour)},
+		{Name: "a-3", Group: "a", Conditions: failed, Created: now.Add(-1 * time.Hour)},
+		{Name: "b-1", Group: "b", Conditions: failed, Created: now.Add(-9 * 24 * time.Hour)},
+		{Name: "b-2", Group: "b", Conditions: succeeded, Created: now.Add(-2 * time.Hour)},
+	}
+
+	testParams := []struct {
+		name string
+		opt  *RunDeleteOptions
+		want []string
+	}{
+		{
+			name: "all",
+			opt:  &RunDeleteOptions{All: true},
+			want: []string{"a-3", "b-2", "a-2", "b-1", "a-1"},
+		},
+		{
+			name: "keep the newest of each group",
+			opt:  &RunDeleteOptions{Keep: 1},
+			want: []string{"a-2", "b-1", "a-1"},
+		},
+		{
+			name: "older than",
+			opt:  &RunDeleteOptions{OlderThan: "7d"},
+			want: []string{"a-2", "b-1", "a-1"},
+		},
+		{
+			name: "status",
+			opt:  &RunDeleteOptions{Status: StatusFailed},
+			want: []string{"a-3", "b-1", "a-1"},
+		},
+		{
+			name: "keep and status",
+			opt:  &RunDeleteOptions{Keep: 2, Status: StatusFailed},
+			want: []string{"a-1"},
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			test.AssertOutput(t, tp.want, tp.opt.Select(runs, now))
+		})
+	}
+}
+
+func TestDeleteOptions_CheckOptions_names(t *testing.T) {
+	opt := &DeleteOptions{Resource: "task"}
+
+	out := &strings.Builder{}
+	err := opt.CheckOptions(&cli.Stream{In: strings.NewReader("n"), Out: out}, "build", "test")
+	if err == nil {
+		t.Fatal("error expected here")
+	}
+	test.AssertOutput(t, "canceled deleting tasks \"build\", \"test\"", err.Error())
+	test.AssertOutput(t, "Are you sure you want to delete tasks \"build\", \"test\" (y/n): ", out.String())
+}
+
+func TestDeleteOptions_CheckOptions_dependents(t *testing.T) {
+	deps := map[string][]string{"build": {"pipeline/ci", "pipeline/release"}}
+
+	opt := &DeleteOptions{Resource: "task", Dependents: deps}
+	out := &strings.Builder{}
+	err := opt.CheckOptions(&cli.Stream{In: strings.NewReader("y"), Out: out}, "build", "test")
+	if err == nil {
+		t.Fatal("error expected here")
+	}
+	test.AssertOutput(t, "refusing to delete task \"build\" still in use, use --force to delete anyway", err.Error())
+	test.AssertOutput(t, "task \"build\" is used by: pipeline/ci, pipeline/release\n", out.String())
+
+	opt = &DeleteOptions{Resource: "task", Dependents: deps, ForceDelete: true}
+	out = &strings.Builder{}
+	if err := opt.CheckOptions(&cli.Stream{Out: out}, "build", "test"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	test.AssertOutput(t, "task \"build\" is used by: pipeline/ci, pipeline/release\n", out.String())
+}
+
+func TestDeleteOptions_KubeDeleteOptions(t *testing.T) {
+	foreground := metav1.DeletePropagationForeground
+	background := metav1.DeletePropagationBackground
+	orphan := metav1.DeletePropagationOrphan
+
+	testParams := []struct {
+		name string
+		opt  *DeleteOptions
+		want *metav1.DeleteOptions
+	}{
+		{
+			name: "no cascade",
+			opt:  &DeleteOptions{},
+			want: &metav1.DeleteOptions{},
+		},
+		{
+			name: "background",
+			opt:  &DeleteOptions{Cascade: CascadeBackground},
+			want: &metav1.DeleteOptions{PropagationPolicy: &background},
+		},
+		{
+			name: "orphan",
+			opt:  &DeleteOptions{Cascade: CascadeOrphan},
+			want: &metav1.DeleteOptions{PropagationPolicy: &orphan},
+		},
+		{
+			name: "wait for the dependents",
+			opt:  &DeleteOptions{Cascade: CascadeBackground, Wait: true},
+			want: &metav1.DeleteOptions{PropagationPolicy: &foreground},
+		},
+		{
+			name: "wait but orphan",
+			opt:  &DeleteOptions{Cascade: CascadeOrphan, Wait: true},
+			want: &metav1.DeleteOptions{PropagationPolicy: &orphan},
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			test.AssertOutput(t, tp.want, tp.opt.KubeDeleteOptions())
+		})
+	}
+}
+
+func TestDeleteOptions_ValidateCascade(t *testing.T) {
+	opt := &DeleteOptions{Cascade: "cascading"}
+	err := opt.ValidateCascade()
+	if err == nil {
+		t.Fatal("error expected here")
+	}
+	test.AssertOutput(t, "invalid cascade \"cascading\", must be one of: foreground|background|orphan", err.Error())
+}
+
+func TestDeleteOptions_DeleteEach(t *testing.T) {
+	opt := &DeleteOptions{Resource: "task"}
+	del := func(name string) error {
+		if strings.HasPrefix(name, "missing") {
+			return fmt.Errorf("failed to delete task %q: not found", name)
+		}
+		return nil
+	}
+
+	errOut := &strings.Builder{}
+	err := opt.DeleteEach(&cli.Stream{Err: errOut}, []string{"build", "missing-1", "test", "missing-2"}, del)
+	if err == nil {
+		t.Fatal("error expected here")
+	}
+	test.AssertOutput(t, "failed to delete 2 of 4 tasks", err.Error())
+	test.AssertOutput(t, "Error: failed to delete task \"missing-1\": not found\nError: failed to delete task \"missing-2\": not found\n", errOut.String())
+
+	errOut.Reset()
+	err = opt.DeleteEach(&cli.Stream{Err: errOut}, []string{"missing-1"}, del)
+	if err == nil {
+		t.Fatal("error expected here")
+	}
+	test.AssertOutput(t, "failed to delete task \"missing-1\": not found", err.Error())
+	test.AssertOutput(t, "", errOut.String())
+}
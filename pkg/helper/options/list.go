@@ -0,0 +1,240 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/helper/status"
+	validate "github.com/tektoncd/cli/pkg/helper/validate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"knative.dev/pkg/apis/duck/v1beta1"
+)
+
+const (
+	StatusSucceeded = status.Succeeded
+	StatusFailed    = status.Failed
+	StatusRunning   = status.Running
+	StatusCancelled = status.Cancelled
+
+	SortByStartTime    = "start-time"
+	SortByDuration     = "duration"
+	SortByName         = "name"
+	SortByStatus       = "status"
+	SortByCreationTime = "creation-time"
+)
+
+var (
+	runStatuses  = []string{StatusSucceeded, StatusFailed, StatusRunning, StatusCancelled}
+	runSortKeys  = []string{SortByStartTime, SortByDuration, SortByName, SortByStatus}
+	listSortKeys = []string{SortByName, SortByCreationTime}
+)
+
+// ListOptions are the options of the list commands selecting and ordering the
+// resources they print. Status, Since and Before only apply to runs.
+type ListOptions struct {
+	AllNamespaces bool
+	Selector      string
+	Status        string
+	Since         string
+	Before        string
+	SortBy        string
+
+	runs bool
+}
+
+// AddFlags adds the flags of the list commands of resources which are not
+// runs, i.e. selecting with labels and sorting by name or creation time
+func (o *ListOptions) AddFlags(c *cobra.Command) {
+	c.Flags().StringVarP(&o.Selector, "selector", "l", "", "selector (label query) to filter on, supports '=', '==', and '!='")
+	c.Flags().StringVarP(&o.SortBy, "sort-by", "", "", "sort by one of: "+strings.Join(listSortKeys, "|"))
+}
+
+// AddRunFlags adds the flags of the list commands of pipelineruns and
+// taskruns, which can also be filtered on their status and start time
+func (o *ListOptions) AddRunFlags(c *cobra.Command) {
+	o.runs = true
+	c.Flags().StringVarP(&o.Selector, "selector", "l", "", "selector (label query) to filter on, supports '=', '==', and '!='")
+	c.Flags().StringVarP(&o.Status, "status", "", "", "only list runs with the given status, one of: "+strings.Join(runStatuses, "|"))
+	c.Flags().StringVarP(&o.Since, "since", "", "", "only list runs started after a duration ago (e.g. 2h, 7d) or a RFC3339 time")
+	c.Flags().StringVarP(&o.Before, "before", "", "", "only list runs started before a duration ago (e.g. 2h, 7d) or a RFC3339 time")
+	c.Flags().StringVarP(&o.SortBy, "sort-by", "", SortByStartTime, "sort by one of: "+strings.Join(runSortKeys, "|"))
+}
+
+// AddAllNamespacesFlag adds the flag listing the resources across all the
+// namespaces, for the list commands of namespaced resources
+func (o *ListOptions) AddAllNamespacesFlag(c *cobra.Command) {
+	c.Flags().BoolVarP(&o.AllNamespaces, "all-namespaces", "A", false, "list the resources in all namespaces")
+}
+
+// Namespace returns the namespace to list the resources from, all the
+// namespaces if AllNamespaces is set
+func (o *ListOptions) Namespace(p cli.Params) string {
+	if o.AllNamespaces {
+		return metav1.NamespaceAll
+	}
+	return p.Namespace()
+}
+
+// ValidateNamespace checks the namespace to list the resources from exists,
+// unless listing across all the namespaces
+func (o *ListOptions) ValidateNamespace(p cli.Params) error {
+	if o.AllNamespaces {
+		return nil
+	}
+	return validate.NamespaceExists(p)
+}
+
+// Validate checks the values of the options
+func (o *ListOptions) Validate() error {
+	if o.Selector != "" {
+		if _, err := labels.Parse(o.Selector); err != nil {
+			return fmt.Errorf("invalid selector %q: %v", o.Selector, err)
+		}
+	}
+
+	sortKeys := listSortKeys
+	if o.runs {
+		sortKeys = runSortKeys
+	}
+	if o.SortBy != "" && !contains(sortKeys, o.SortBy) {
+		return fmt.Errorf("invalid sort key %q, must be one of: %s", o.SortBy, strings.Join(sortKeys, "|"))
+	}
+
+	if o.Status != "" && !contains(runStatuses, o.Status) {
+		return fmt.Errorf("invalid status %q, must be one of: %s", o.Status, strings.Join(runStatuses, "|"))
+	}
+
+	for _, t := range []string{o.Since, o.Before} {
+		if t == "" {
+			continue
+		}
+		if _, err := ParseTime(t, time.Now()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LabelSelector returns the selector given by the user along with the
+// selectors the command adds, e.g. to list the runs of a pipeline
+func (o *ListOptions) LabelSelector(selectors ...string) string {
+	all := []string{}
+	for _, s := range append(selectors, o.Selector) {
+		if s != "" {
+			all = append(all, s)
+		}
+	}
+	return strings.Join(all, ",")
+}
+
+// MatchRun returns whether a run with the given conditions and start time
+// matches the status, since and before options
+func (o *ListOptions) MatchRun(conditions v1beta1.Conditions, start *metav1.Time, now time.Time) bool {
+	if o.Status != "" && RunStatus(conditions) != o.Status {
+		return false
+	}
+
+	if o.Since == "" && o.Before == "" {
+		return true
+	}
+	if start.IsZero() {
+		return false
+	}
+
+	if o.Since != "" {
+		if since, err := ParseTime(o.Since, now); err == nil && start.Time.Before(since) {
+			return false
+		}
+	}
+	if o.Before != "" {
+		if before, err := ParseTime(o.Before, now); err == nil && !start.Time.Before(before) {
+			return false
+		}
+	}
+	return true
+}
+
+// SortObjects sorts the items of a list, get returns the metadata of the
+// i-th item. Items are left in the order they were listed if no sort key
+// was given.
+func (o *ListOptions) SortObjects(items interface{}, get func(i int) metav1.Object) {
+	switch o.SortBy {
+	case SortByName:
+		sort.SliceStable(items, func(i, j int) bool {
+			return get(i).GetName() < get(j).GetName()
+		})
+	case SortByCreationTime:
+		sort.SliceStable(items, func(i, j int) bool {
+			ti, tj := get(i).GetCreationTimestamp(), get(j).GetCreationTimestamp()
+			return tj.Before(&ti)
+		})
+	}
+}
+
+// RunStatus reduces the conditions of a run to one of succeeded, failed,
+// running or cancelled
+func RunStatus(conditions v1beta1.Conditions) string {
+	return status.Run(conditions)
+}
+
+// ParseTime returns the time given either as a duration before now, which
+// can be expressed in days e.g. 7d, or as a RFC3339 time
+func ParseTime(value string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	d, err := ParseDuration(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, must be a duration like 2h or 7d, or a RFC3339 time", value)
+	}
+	return now.Add(-d), nil
+}
+
+// ParseDuration parses a duration like time.ParseDuration, also accepting
+// a number of days e.g. 7d
+func ParseDuration(value string) (time.Duration, error) {
+	if strings.HasSuffix(value, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(value, "d"))
+		if err != nil || days < 0 {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	return d, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,209 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/test"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/apis/duck/v1beta1"
+)
+
+func TestListOptions_Validate(t *testing.T) {
+	testParams := []struct {
+		name string
+		opt  *ListOptions
+		want string
+	}{
+		{
+			name: "Valid options",
+			opt:  &ListOptions{Selector: "a=b,c!=d", Status: "failed", Since: "7d", Before: "2019-12-01T10:00:00Z", SortBy: "duration", runs: true},
+			want: "",
+		},
+		{
+			name: "Invalid selector",
+			opt:  &ListOptions{Selector: "a=b=c"},
+			want: "invalid selector \"a=b=c\"",
+		},
+		{
+			name: "Invalid status",
+			opt:  &ListOptions{Status: "done", runs: true},
+			want: "invalid status \"done\", must be one of: succeeded|failed|running|cancelled",
+		},
+		{
+			name: "Sort key of runs",
+			opt:  &ListOptions{SortBy: "duration"},
+			want: "invalid sort key \"duration\", must be one of: name|creation-time",
+		},
+		{
+			name: "Invalid since",
+			opt:  &ListOptions{Since: "yesterday", runs: true},
+			want: "invalid time \"yesterday\", must be a duration like 2h or 7d, or a RFC3339 time",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			err := tp.opt.Validate()
+			if tp.want == "" {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Expected error %q, got nil", tp.want)
+			}
+			if len(err.Error()) < len(tp.want) || err.Error()[:len(tp.want)] != tp.want {
+				t.Errorf("Expected error %q, got %q", tp.want, err.Error())
+			}
+		})
+	}
+}
+
+func TestListOptions_LabelSelector(t *testing.T) {
+	opt := &ListOptions{Selector: "app=foo"}
+	test.AssertOutput(t, "tekton.dev/pipeline=bar,app=foo", opt.LabelSelector("tekton.dev/pipeline=bar", ""))
+
+	opt = &ListOptions{}
+	test.AssertOutput(t, "", opt.LabelSelector())
+}
+
+func TestListOptions_MatchRun(t *testing.T) {
+	now := time.Date(2019, 12, 10, 12, 0, 0, 0, time.UTC)
+	hourAgo := &metav1.Time{Time: now.Add(-1 * time.Hour)}
+	weekAgo := &metav1.Time{Time: now.Add(-7 * 24 * time.Hour)}
+	failed := v1beta1.Conditions{{Type: apis.ConditionSucceeded, Status: corev1.ConditionFalse}}
+
+	testParams := []struct {
+		name       string
+		opt        *ListOptions
+		conditions v1beta1.Conditions
+		start      *metav1.Time
+		want       bool
+	}{
+		{
+			name:  "No filter",
+			opt:   &ListOptions{},
+			start: nil,
+			want:  true,
+		},
+		{
+			name:       "Matching status",
+			opt:        &ListOptions{Status: StatusFailed},
+			conditions: failed,
+			want:       true,
+		},
+		{
+			name: "Not matching status",
+			opt:  &ListOptions{Status: StatusFailed},
+			want: false,
+		},
+		{
+			name:  "Started since",
+			opt:   &ListOptions{Since: "2d"},
+			start: hourAgo,
+			want:  true,
+		},
+		{
+			name:  "Started before since",
+			opt:   &ListOptions{Since: "2d"},
+			start: weekAgo,
+			want:  false,
+		},
+		{
+			name:  "Started before",
+			opt:   &ListOptions{Before: "2019-12-05T00:00:00Z"},
+			start: weekAgo,
+			want:  true,
+		},
+		{
+			name:  "Started after before",
+			opt:   &ListOptions{Before: "2019-12-05T00:00:00Z"},
+			start: hourAgo,
+			want:  false,
+		},
+		{
+			name:  "Not started",
+			opt:   &ListOptions{Before: "2h"},
+			start: nil,
+			want:  false,
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			if got := tp.opt.MatchRun(tp.conditions, tp.start, now); got != tp.want {
+				t.Errorf("Expected %v, got %v", tp.want, got)
+			}
+		})
+	}
+}
+
+func TestRunStatus(t *testing.T) {
+	testParams := []struct {
+		conditions v1beta1.Conditions
+		want       string
+	}{
+		{
+			conditions: nil,
+			want:       StatusRunning,
+		},
+		{
+			conditions: v1beta1.Conditions{{Status: corev1.ConditionUnknown, Reason: "Running"}},
+			want:       StatusRunning,
+		},
+		{
+			conditions: v1beta1.Conditions{{Status: corev1.ConditionTrue}},
+			want:       StatusSucceeded,
+		},
+		{
+			conditions: v1beta1.Conditions{{Status: corev1.ConditionFalse, Reason: "Failed"}},
+			want:       StatusFailed,
+		},
+		{
+			conditions: v1beta1.Conditions{{Status: corev1.ConditionFalse, Reason: "PipelineRunCancelled"}},
+			want:       StatusCancelled,
+		},
+	}
+
+	for _, tp := range testParams {
+		test.AssertOutput(t, tp.want, RunStatus(tp.conditions))
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	d, err := ParseDuration("7d")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	test.AssertOutput(t, 168*time.Hour, d)
+
+	d, err = ParseDuration("90m")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	test.AssertOutput(t, 90*time.Minute, d)
+
+	for _, invalid := range []string{"", "d", "-1d", "-2h", "week"} {
+		if _, err := ParseDuration(invalid); err == nil {
+			t.Errorf("Expected error for %q", invalid)
+		}
+	}
+}
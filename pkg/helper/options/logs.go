@@ -35,6 +35,7 @@ const (
 type LogOptions struct {
 	AllSteps        bool
 	Follow          bool
+	Timestamps      bool
 	Params          cli.Params
 	PipelineName    string
 	PipelineRunName string
@@ -0,0 +1,146 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"sort"
+
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	"github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TaskDependents returns the names of the pipelines referencing each of the
+// tasks of the given kind in their tasks
+func TaskDependents(tekton versioned.Interface, ns string, kind v1alpha1.TaskKind, names []string) (map[string][]string, error) {
+	return pipelineDependents(tekton, ns, names, func(p v1alpha1.Pipeline) []string {
+		refs := []string{}
+		for _, t := range p.Spec.Tasks {
+			k := t.TaskRef.Kind
+			if k == "" {
+				k = v1alpha1.NamespacedTaskKind
+			}
+			if k == kind {
+				refs = append(refs, t.TaskRef.Name)
+			}
+		}
+		return refs
+	})
+}
+
+// ConditionDependents returns the names of the pipelines referencing each of
+// the conditions in the conditions of their tasks
+func ConditionDependents(tekton versioned.Interface, ns string, names []string) (map[string][]string, error) {
+	return pipelineDependents(tekton, ns, names, func(p v1alpha1.Pipeline) []string {
+		refs := []string{}
+		for _, t := range p.Spec.Tasks {
+			for _, c := range t.Conditions {
+				refs = append(refs, c.ConditionRef)
+			}
+		}
+		return refs
+	})
+}
+
+// ResourceDependents returns the pipelines and tasks bound to each of the
+// pipelineresources by any of their runs. Pipelines and tasks only declare
+// the types of their resources, their runs are what binds them.
+func ResourceDependents(tekton versioned.Interface, ns string, names []string) (map[string][]string, error) {
+	prs, err := tekton.TektonV1alpha1().PipelineRuns(ns).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	trs, err := tekton.TektonV1alpha1().TaskRuns(ns).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	refs := map[string][]string{}
+	for _, run := range prs.Items {
+		dependent := "pipelinerun/" + run.Name
+		if run.Spec.PipelineRef != nil {
+			dependent = "pipeline/" + run.Spec.PipelineRef.Name
+		}
+		for _, r := range run.Spec.Resources {
+			if r.ResourceRef != nil {
+				refs[dependent] = append(refs[dependent], r.ResourceRef.Name)
+			}
+		}
+	}
+
+	for _, run := range trs.Items {
+		dependent := taskRunDependent(run)
+		bindings := append(append([]v1alpha1.TaskResourceBinding{}, run.Spec.Inputs.Resources...), run.Spec.Outputs.Resources...)
+		for _, r := range bindings {
+			if r.ResourceRef != nil {
+				refs[dependent] = append(refs[dependent], r.ResourceRef.Name)
+			}
+		}
+	}
+	return dependents(refs, names), nil
+}
+
+// taskRunDependent returns the pipeline a taskrun was run by, or else the
+// task it ran
+func taskRunDependent(run v1alpha1.TaskRun) string {
+	if name := run.Labels["tekton.dev/pipeline"]; name != "" {
+		return "pipeline/" + name
+	}
+	if run.Spec.TaskRef == nil {
+		return "taskrun/" + run.Name
+	}
+	if run.Spec.TaskRef.Kind == v1alpha1.ClusterTaskKind {
+		return "clustertask/" + run.Spec.TaskRef.Name
+	}
+	return "task/" + run.Spec.TaskRef.Name
+}
+
+func pipelineDependents(tekton versioned.Interface, ns string, names []string, refsOf func(p v1alpha1.Pipeline) []string) (map[string][]string, error) {
+	pipelines, err := tekton.TektonV1alpha1().Pipelines(ns).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	refs := map[string][]string{}
+	for _, p := range pipelines.Items {
+		refs["pipeline/"+p.Name] = refsOf(p)
+	}
+	return dependents(refs, names), nil
+}
+
+// dependents inverts the references of the dependents to the names, listing
+// each dependent once per name in alphabetical order
+func dependents(refs map[string][]string, names []string) map[string][]string {
+	deps := map[string][]string{}
+	for _, name := range names {
+		for dependent, r := range refs {
+			if contains(r, name) {
+				deps[name] = append(deps[name], dependent)
+			}
+		}
+		sort.Strings(deps[name])
+	}
+	return deps
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
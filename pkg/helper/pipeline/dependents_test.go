@@ -0,0 +1,130 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jonboulle/clockwork"
+	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	tb "github.com/tektoncd/pipeline/test/builder"
+)
+
+func TestTaskDependents(t *testing.T) {
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		Pipelines: []*v1alpha1.Pipeline{
+			tb.Pipeline("release", "ns", tb.PipelineSpec(
+				tb.PipelineTask("build", "build"),
+				tb.PipelineTask("test", "test", tb.PipelineTaskRefKind(v1alpha1.ClusterTaskKind)),
+			)),
+			tb.Pipeline("ci", "ns", tb.PipelineSpec(tb.PipelineTask("build", "build"))),
+			tb.Pipeline("other", "other-ns", tb.PipelineSpec(tb.PipelineTask("test", "test"))),
+		},
+	})
+
+	deps, err := TaskDependents(cs.Pipeline, "ns", v1alpha1.NamespacedTaskKind, []string{"build", "test"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d := cmp.Diff(map[string][]string{"build": {"pipeline/ci", "pipeline/release"}}, deps); d != "" {
+		t.Errorf("unexpected dependents: %s", d)
+	}
+
+	deps, err = TaskDependents(cs.Pipeline, "ns", v1alpha1.ClusterTaskKind, []string{"build", "test"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d := cmp.Diff(map[string][]string{"test": {"pipeline/release"}}, deps); d != "" {
+		t.Errorf("unexpected dependents: %s", d)
+	}
+}
+
+func TestConditionDependents(t *testing.T) {
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		Pipelines: []*v1alpha1.Pipeline{
+			tb.Pipeline("release", "ns", tb.PipelineSpec(
+				tb.PipelineTask("build", "build", tb.PipelineTaskCondition("has-tag")),
+			)),
+			tb.Pipeline("ci", "ns", tb.PipelineSpec(tb.PipelineTask("build", "build"))),
+		},
+	})
+
+	deps, err := ConditionDependents(cs.Pipeline, "ns", []string{"has-tag", "is-main"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d := cmp.Diff(map[string][]string{"has-tag": {"pipeline/release"}}, deps); d != "" {
+		t.Errorf("unexpected dependents: %s", d)
+	}
+}
+
+func TestResourceDependents(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		PipelineRuns: []*v1alpha1.PipelineRun{
+			tb.PipelineRun("release-1", "ns",
+				cb.PipelineRunCreationTimestamp(clock.Now()),
+				tb.PipelineRunSpec("release", tb.PipelineRunResourceBinding("source", tb.PipelineResourceBindingRef("old-git"))),
+			),
+			tb.PipelineRun("release-2", "ns",
+				cb.PipelineRunCreationTimestamp(clock.Now().Add(time.Minute)),
+				tb.PipelineRunSpec("release", tb.PipelineRunResourceBinding("source", tb.PipelineResourceBindingRef("git"))),
+			),
+			tb.PipelineRun("ci-1", "ns",
+				cb.PipelineRunCreationTimestamp(clock.Now()),
+				tb.PipelineRunSpec("ci",
+					tb.PipelineRunResourceBinding("source", tb.PipelineResourceBindingRef("git")),
+					tb.PipelineRunResourceBinding("image", tb.PipelineResourceBindingRef("image")),
+				),
+			),
+		},
+		TaskRuns: []*v1alpha1.TaskRun{
+			tb.TaskRun("build-1", "ns",
+				tb.TaskRunSpec(
+					tb.TaskRunTaskRef("build"),
+					tb.TaskRunInputs(tb.TaskRunInputsResource("source", tb.TaskResourceBindingRef("git"))),
+					tb.TaskRunOutputs(tb.TaskRunOutputsResource("image", tb.TaskResourceBindingRef("registry"))),
+				),
+			),
+			tb.TaskRun("deploy-1", "ns",
+				tb.TaskRunLabel("tekton.dev/pipeline", "deploy"),
+				tb.TaskRunSpec(
+					tb.TaskRunTaskRef("deploy", tb.TaskRefKind(v1alpha1.ClusterTaskKind)),
+					tb.TaskRunInputs(tb.TaskRunInputsResource("image", tb.TaskResourceBindingRef("registry"))),
+				),
+			),
+		},
+	})
+
+	deps, err := ResourceDependents(cs.Pipeline, "ns", []string{"git", "old-git", "image", "registry", "unused"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string][]string{
+		"git":      {"pipeline/ci", "pipeline/release", "task/build"},
+		"old-git":  {"pipeline/release"},
+		"image":    {"pipeline/ci"},
+		"registry": {"pipeline/deploy", "task/build"},
+	}
+	if d := cmp.Diff(want, deps); d != "" {
+		t.Errorf("unexpected dependents: %s", d)
+	}
+}
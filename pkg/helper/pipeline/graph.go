@@ -0,0 +1,100 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipeline/dag"
+)
+
+const (
+	// EdgeRunAfter is an ordering constraint declared with runAfter
+	EdgeRunAfter = "runAfter"
+	// EdgeFrom is an ordering constraint implied by an input resource `from`
+	EdgeFrom = "from"
+)
+
+// Edge links two PipelineTasks of a Pipeline, From has to finish before To
+// can start
+type Edge struct {
+	From     string
+	To       string
+	Kind     string
+	Resource string
+}
+
+// Graph is the DAG of the tasks of a Pipeline
+type Graph struct {
+	Tasks []v1alpha1.PipelineTask
+	Edges []Edge
+}
+
+// BuildGraph returns the Graph of the tasks of the given Pipeline. The tasks
+// and edges keep the order in which they are declared in the Pipeline spec.
+// Returns an error if the Pipeline is not a valid DAG.
+func BuildGraph(p *v1alpha1.Pipeline) (*Graph, error) {
+	// validates the links between the tasks and makes sure there is no cycle
+	if _, err := dag.Build(v1alpha1.PipelineTaskList(p.Spec.Tasks)); err != nil {
+		return nil, err
+	}
+
+	g := &Graph{Tasks: p.Spec.Tasks}
+	for _, t := range p.Spec.Tasks {
+		for _, prev := range t.RunAfter {
+			g.Edges = append(g.Edges, Edge{From: prev, To: t.Name, Kind: EdgeRunAfter})
+		}
+
+		if t.Resources == nil {
+			continue
+		}
+		for _, in := range t.Resources.Inputs {
+			for _, prev := range in.From {
+				g.Edges = append(g.Edges, Edge{From: prev, To: t.Name, Kind: EdgeFrom, Resource: in.Resource})
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// Roots returns the name of the tasks which do not depend on any other task
+func (g *Graph) Roots() []string {
+	hasPrev := map[string]bool{}
+	for _, e := range g.Edges {
+		hasPrev[e.To] = true
+	}
+
+	roots := []string{}
+	for _, t := range g.Tasks {
+		if !hasPrev[t.Name] {
+			roots = append(roots, t.Name)
+		}
+	}
+	return roots
+}
+
+// Next returns the edges leaving the given task, without duplicate targets
+func (g *Graph) Next(task string) []Edge {
+	seen := map[string]bool{}
+	next := []Edge{}
+	for _, e := range g.Edges {
+		if e.From != task || seen[e.To] {
+			continue
+		}
+		seen[e.To] = true
+		next = append(next, e)
+	}
+	return next
+}
@@ -17,6 +17,7 @@ package pipelinerun
 import (
 	"sort"
 
+	"github.com/tektoncd/cli/pkg/helper/status"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
 )
 
@@ -34,3 +35,40 @@ func SortPipelineRunsByStartTime(prs []v1alpha1.PipelineRun) []v1alpha1.Pipeline
 
 	return prs
 }
+
+// SortPipelineRunsByName sorts the pipelineruns by name, keeping the order
+// of pipelineruns with the same name
+func SortPipelineRunsByName(prs []v1alpha1.PipelineRun) []v1alpha1.PipelineRun {
+	sort.SliceStable(prs, func(i, j int) bool {
+		return prs[i].Name < prs[j].Name
+	})
+
+	return prs
+}
+
+// SortPipelineRunsByDuration sorts the pipelineruns longest first, the
+// pipelineruns which have not completed are moved last
+func SortPipelineRunsByDuration(prs []v1alpha1.PipelineRun) []v1alpha1.PipelineRun {
+	duration := func(pr v1alpha1.PipelineRun) int64 {
+		if pr.Status.StartTime == nil || pr.Status.CompletionTime == nil {
+			return -1
+		}
+		return int64(pr.Status.CompletionTime.Sub(pr.Status.StartTime.Time))
+	}
+
+	sort.SliceStable(prs, func(i, j int) bool {
+		return duration(prs[i]) > duration(prs[j])
+	})
+
+	return prs
+}
+
+// SortPipelineRunsByStatus groups the pipelineruns running first, then the
+// failed, cancelled and succeeded ones, keeping their order in each group
+func SortPipelineRunsByStatus(prs []v1alpha1.PipelineRun) []v1alpha1.PipelineRun {
+	sort.SliceStable(prs, func(i, j int) bool {
+		return status.Less(prs[i].Status.Conditions, prs[j].Status.Conditions)
+	})
+
+	return prs
+}
@@ -18,9 +18,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/jonboulle/clockwork"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
 )
 
 func Test_PipelineRunsByStartTime(t *testing.T) {
@@ -124,3 +127,56 @@ func Test_PipelineRunsByStartTime_NilStartTime(t *testing.T) {
 		t.Errorf("SortPipelineRunsByStartTime should be pr0-1 but returned: %s", element3)
 	}
 }
+
+func Test_PipelineRunsByDurationAndStatus(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	pipelineRun := func(name string, status corev1.ConditionStatus, reason string, duration time.Duration) v1alpha1.PipelineRun {
+		pr := v1alpha1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "namespace",
+				Name:      name,
+			},
+		}
+		pr.Status.StartTime = &metav1.Time{Time: clock.Now()}
+		if duration > 0 {
+			pr.Status.CompletionTime = &metav1.Time{Time: clock.Now().Add(duration)}
+		}
+		pr.Status.SetCondition(&apis.Condition{
+			Type:   apis.ConditionSucceeded,
+			Status: status,
+			Reason: reason,
+		})
+		return pr
+	}
+
+	prs := []v1alpha1.PipelineRun{
+		pipelineRun("succeeded", corev1.ConditionTrue, "Succeeded", time.Minute),
+		pipelineRun("running", corev1.ConditionUnknown, "Running", 0),
+		pipelineRun("cancelled", corev1.ConditionFalse, "PipelineRunCancelled", 2*time.Minute),
+		pipelineRun("failed", corev1.ConditionFalse, "Failed", 3*time.Minute),
+	}
+
+	names := func(prs []v1alpha1.PipelineRun) []string {
+		ret := []string{}
+		for _, pr := range prs {
+			ret = append(ret, pr.Name)
+		}
+		return ret
+	}
+
+	byDuration := names(SortPipelineRunsByDuration(prs))
+	if d := cmp.Diff([]string{"failed", "cancelled", "succeeded", "running"}, byDuration); d != "" {
+		t.Errorf("SortPipelineRunsByDuration returned unexpected order: %s", d)
+	}
+
+	byStatus := names(SortPipelineRunsByStatus(prs))
+	if d := cmp.Diff([]string{"running", "failed", "cancelled", "succeeded"}, byStatus); d != "" {
+		t.Errorf("SortPipelineRunsByStatus returned unexpected order: %s", d)
+	}
+
+	byName := names(SortPipelineRunsByName(prs))
+	if d := cmp.Diff([]string{"cancelled", "failed", "running", "succeeded"}, byName); d != "" {
+		t.Errorf("SortPipelineRunsByName returned unexpected order: %s", d)
+	}
+}
@@ -78,7 +78,7 @@ func TestContainer_fetch_logs(t *testing.T) {
 	}
 
 	for _, d := range td {
-		lr := pod.Container(d.container).LogReader(d.follow)
+		lr := pod.Container(d.container).LogReader(d.follow, false)
 		output, err := containerLogs(lr)
 
 		if err != nil {
@@ -0,0 +1,135 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pods
+
+import (
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+// Event is a kubernetes event reported about an object, e.g. a pod which
+// can not be scheduled or whose image can not be pulled
+type Event struct {
+	Object   string
+	Type     string
+	Reason   string
+	Message  string
+	Count    int32
+	LastSeen *metav1.Time
+}
+
+// Events returns the events of the namespace about the given objects, which
+// are selected on the server by their kind and name, oldest first
+func Events(kube k8s.Interface, ns string, objects ...corev1.ObjectReference) ([]Event, error) {
+	events := []Event{}
+	for _, o := range objects {
+		selector := fields.Set{
+			"involvedObject.kind": o.Kind,
+			"involvedObject.name": o.Name,
+		}.AsSelector().String()
+
+		list, err := kube.CoreV1().Events(ns).List(metav1.ListOptions{FieldSelector: selector})
+		if err != nil {
+			return nil, err
+		}
+
+		name := objectName(o)
+		for _, e := range list.Items {
+			// the fake clientsets do not filter on the fields
+			if objectName(e.InvolvedObject) != name {
+				continue
+			}
+
+			events = append(events, Event{
+				Object:   name,
+				Type:     e.Type,
+				Reason:   e.Reason,
+				Message:  strings.TrimSpace(e.Message),
+				Count:    e.Count,
+				LastSeen: lastSeen(e),
+			})
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastSeen.Before(events[j].LastSeen)
+	})
+	return events, nil
+}
+
+func objectName(o corev1.ObjectReference) string {
+	return strings.ToLower(o.Kind) + "/" + o.Name
+}
+
+// lastSeen returns when the event was last reported, events recorded with the
+// events.k8s.io API only set the EventTime
+func lastSeen(e corev1.Event) *metav1.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return &e.LastTimestamp
+	case !e.EventTime.IsZero():
+		return &metav1.Time{Time: e.EventTime.Time}
+	}
+	return &e.FirstTimestamp
+}
+
+// Waiting is a container of a pod which is not running yet
+type Waiting struct {
+	Container string
+	Reason    string
+	Message   string
+}
+
+// Diagnostics is what a pod reports about why it is not running, i.e. the
+// conditions which are not met and the reasons its containers are waiting
+type Diagnostics struct {
+	Conditions []corev1.PodCondition
+	Waiting    []Waiting
+}
+
+// Diagnose returns the conditions of the pod which are not met and the
+// containers, init containers included, which are waiting
+func Diagnose(pod *corev1.Pod) Diagnostics {
+	d := Diagnostics{
+		Conditions: []corev1.PodCondition{},
+		Waiting:    []Waiting{},
+	}
+
+	for _, c := range pod.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			d.Conditions = append(d.Conditions, c)
+		}
+	}
+
+	statuses := append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+	for _, s := range statuses {
+		if s.State.Waiting == nil {
+			continue
+		}
+		d.Waiting = append(d.Waiting, Waiting{
+			Container: s.Name,
+			Reason:    s.State.Waiting.Reason,
+			Message:   strings.TrimSpace(s.State.Waiting.Message),
+		})
+	}
+
+	return d
+}
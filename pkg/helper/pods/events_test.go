@@ -0,0 +1,141 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pods
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stest "k8s.io/client-go/testing"
+)
+
+func TestEvents(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	kc := fake.NewSimpleClientset(
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "e1", Namespace: "ns"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "pod"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "Failed",
+			Message:        "Error: ErrImagePull\n",
+			Count:          3,
+			LastTimestamp:  metav1.Time{Time: now.Add(time.Minute)},
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "e2", Namespace: "ns"},
+			InvolvedObject: corev1.ObjectReference{Kind: "TaskRun", Name: "tr"},
+			Type:           corev1.EventTypeNormal,
+			Reason:         "Started",
+			EventTime:      metav1.MicroTime{Time: now},
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "e3", Namespace: "ns"},
+			InvolvedObject: corev1.ObjectReference{Kind: "TaskRun", Name: "pod"},
+			Reason:         "Ignored",
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "e4", Namespace: "other"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "pod"},
+			Reason:         "Ignored",
+		},
+	)
+
+	selectors := []string{}
+	kc.PrependReactor("list", "events", func(action k8stest.Action) (bool, runtime.Object, error) {
+		selectors = append(selectors, action.(k8stest.ListAction).GetListRestrictions().Fields.String())
+		return false, nil, nil
+	})
+
+	got, err := Events(kc, "ns",
+		corev1.ObjectReference{Kind: "TaskRun", Name: "tr"},
+		corev1.ObjectReference{Kind: "Pod", Name: "pod"},
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []Event{
+		{
+			Object:   "taskrun/tr",
+			Type:     corev1.EventTypeNormal,
+			Reason:   "Started",
+			LastSeen: &metav1.Time{Time: now},
+		},
+		{
+			Object:   "pod/pod",
+			Type:     corev1.EventTypeWarning,
+			Reason:   "Failed",
+			Message:  "Error: ErrImagePull",
+			Count:    3,
+			LastSeen: &metav1.Time{Time: now.Add(time.Minute)},
+		},
+	}
+	if d := cmp.Diff(expected, got); d != "" {
+		t.Errorf("Unexpected events: %s", d)
+	}
+
+	expectedSelectors := []string{
+		"involvedObject.kind=TaskRun,involvedObject.name=tr",
+		"involvedObject.kind=Pod,involvedObject.name=pod",
+	}
+	if d := cmp.Diff(expectedSelectors, selectors); d != "" {
+		t.Errorf("Unexpected field selectors: %s", d)
+	}
+}
+
+func TestDiagnose(t *testing.T) {
+	pod := &corev1.Pod{
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodScheduled, Status: corev1.ConditionTrue},
+				{Type: corev1.PodInitialized, Status: corev1.ConditionFalse, Reason: "ContainersNotInitialized"},
+			},
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:  "place-tools",
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ErrImagePull"}},
+				},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:  "step-build",
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "PodInitializing"}},
+				},
+				{
+					Name:  "step-done",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Completed"}},
+				},
+			},
+		},
+	}
+
+	expected := Diagnostics{
+		Conditions: []corev1.PodCondition{
+			{Type: corev1.PodInitialized, Status: corev1.ConditionFalse, Reason: "ContainersNotInitialized"},
+		},
+		Waiting: []Waiting{
+			{Container: "place-tools", Reason: "ErrImagePull"},
+			{Container: "step-build", Reason: "PodInitializing"},
+		},
+	}
+	if d := cmp.Diff(expected, Diagnose(pod)); d != "" {
+		t.Errorf("Unexpected diagnostics: %s", d)
+	}
+}
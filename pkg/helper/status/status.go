@@ -0,0 +1,63 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis/duck/v1beta1"
+)
+
+const (
+	Succeeded = "succeeded"
+	Failed    = "failed"
+	Running   = "running"
+	Cancelled = "cancelled"
+)
+
+// order groups the runs running first, then the failed, cancelled and
+// succeeded ones
+var order = map[string]int{
+	Running:   0,
+	Failed:    1,
+	Cancelled: 2,
+	Succeeded: 3,
+}
+
+// Run reduces the conditions of a run to one of succeeded, failed, running
+// or cancelled
+func Run(conditions v1beta1.Conditions) string {
+	if len(conditions) == 0 {
+		return Running
+	}
+
+	switch conditions[0].Status {
+	case corev1.ConditionTrue:
+		return Succeeded
+	case corev1.ConditionFalse:
+		if strings.HasSuffix(conditions[0].Reason, "Cancelled") {
+			return Cancelled
+		}
+		return Failed
+	}
+	return Running
+}
+
+// Less reports whether a run with the conditions a is listed before a run
+// with the conditions b when they are sorted by status
+func Less(a, b v1beta1.Conditions) bool {
+	return order[Run(a)] < order[Run(b)]
+}
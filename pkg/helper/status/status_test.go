@@ -0,0 +1,44 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/apis/duck/v1beta1"
+)
+
+func conditions(status corev1.ConditionStatus, reason string) v1beta1.Conditions {
+	return v1beta1.Conditions{{Type: apis.ConditionSucceeded, Status: status, Reason: reason}}
+}
+
+func TestRun(t *testing.T) {
+	assert.Equal(t, Running, Run(nil))
+	assert.Equal(t, Running, Run(conditions(corev1.ConditionUnknown, "Running")))
+	assert.Equal(t, Succeeded, Run(conditions(corev1.ConditionTrue, "Succeeded")))
+	assert.Equal(t, Failed, Run(conditions(corev1.ConditionFalse, "Failed")))
+	assert.Equal(t, Cancelled, Run(conditions(corev1.ConditionFalse, "PipelineRunCancelled")))
+}
+
+func TestLess(t *testing.T) {
+	assert.True(t, Less(nil, conditions(corev1.ConditionFalse, "Failed")))
+	assert.True(t, Less(conditions(corev1.ConditionFalse, "Failed"), conditions(corev1.ConditionFalse, "TaskRunCancelled")))
+	assert.True(t, Less(conditions(corev1.ConditionFalse, "TaskRunCancelled"), conditions(corev1.ConditionTrue, "Succeeded")))
+	assert.False(t, Less(conditions(corev1.ConditionTrue, "Succeeded"), nil))
+	assert.False(t, Less(nil, nil))
+}
@@ -17,6 +17,7 @@ package taskrun
 import (
 	"sort"
 
+	"github.com/tektoncd/cli/pkg/helper/status"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
 )
 
@@ -34,3 +35,40 @@ func SortTaskRunsByStartTime(trs []v1alpha1.TaskRun) []v1alpha1.TaskRun {
 
 	return trs
 }
+
+// SortTaskRunsByName sorts the taskruns by name, keeping the order of
+// taskruns with the same name
+func SortTaskRunsByName(trs []v1alpha1.TaskRun) []v1alpha1.TaskRun {
+	sort.SliceStable(trs, func(i, j int) bool {
+		return trs[i].Name < trs[j].Name
+	})
+
+	return trs
+}
+
+// SortTaskRunsByDuration sorts the taskruns longest first, the taskruns
+// which have not completed are moved last
+func SortTaskRunsByDuration(trs []v1alpha1.TaskRun) []v1alpha1.TaskRun {
+	duration := func(tr v1alpha1.TaskRun) int64 {
+		if tr.Status.StartTime == nil || tr.Status.CompletionTime == nil {
+			return -1
+		}
+		return int64(tr.Status.CompletionTime.Sub(tr.Status.StartTime.Time))
+	}
+
+	sort.SliceStable(trs, func(i, j int) bool {
+		return duration(trs[i]) > duration(trs[j])
+	})
+
+	return trs
+}
+
+// SortTaskRunsByStatus groups the taskruns running first, then the failed,
+// cancelled and succeeded ones, keeping their order in each group
+func SortTaskRunsByStatus(trs []v1alpha1.TaskRun) []v1alpha1.TaskRun {
+	sort.SliceStable(trs, func(i, j int) bool {
+		return status.Less(trs[i].Status.Conditions, trs[j].Status.Conditions)
+	})
+
+	return trs
+}
@@ -18,9 +18,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/jonboulle/clockwork"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
 )
 
 func Test_TaskRunsByStartTime(t *testing.T) {
@@ -124,3 +127,56 @@ func Test_TaskRunsByStartTime_NilStartTime(t *testing.T) {
 		t.Errorf("SortTaskRunsByStartTime should be tr0-1 but returned: %s", element3)
 	}
 }
+
+func Test_TaskRunsByDurationAndStatus(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	taskRun := func(name string, status corev1.ConditionStatus, reason string, duration time.Duration) v1alpha1.TaskRun {
+		tr := v1alpha1.TaskRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "namespace",
+				Name:      name,
+			},
+		}
+		tr.Status.StartTime = &metav1.Time{Time: clock.Now()}
+		if duration > 0 {
+			tr.Status.CompletionTime = &metav1.Time{Time: clock.Now().Add(duration)}
+		}
+		tr.Status.SetCondition(&apis.Condition{
+			Type:   apis.ConditionSucceeded,
+			Status: status,
+			Reason: reason,
+		})
+		return tr
+	}
+
+	trs := []v1alpha1.TaskRun{
+		taskRun("succeeded", corev1.ConditionTrue, "Succeeded", time.Minute),
+		taskRun("running", corev1.ConditionUnknown, "Running", 0),
+		taskRun("cancelled", corev1.ConditionFalse, "TaskRunCancelled", 2*time.Minute),
+		taskRun("failed", corev1.ConditionFalse, "Failed", 3*time.Minute),
+	}
+
+	names := func(trs []v1alpha1.TaskRun) []string {
+		ret := []string{}
+		for _, tr := range trs {
+			ret = append(ret, tr.Name)
+		}
+		return ret
+	}
+
+	byDuration := names(SortTaskRunsByDuration(trs))
+	if d := cmp.Diff([]string{"failed", "cancelled", "succeeded", "running"}, byDuration); d != "" {
+		t.Errorf("SortTaskRunsByDuration returned unexpected order: %s", d)
+	}
+
+	byStatus := names(SortTaskRunsByStatus(trs))
+	if d := cmp.Diff([]string{"running", "failed", "cancelled", "succeeded"}, byStatus); d != "" {
+		t.Errorf("SortTaskRunsByStatus returned unexpected order: %s", d)
+	}
+
+	byName := names(SortTaskRunsByName(trs))
+	if d := cmp.Diff([]string{"cancelled", "failed", "running", "succeeded"}, byName); d != "" {
+		t.Errorf("SortTaskRunsByName returned unexpected order: %s", d)
+	}
+}
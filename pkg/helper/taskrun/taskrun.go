@@ -31,17 +31,18 @@ type Run struct {
 func (t *Run) NewLogReader(ns string, clientSet *cli.Clients,
 	streamer stream.NewStreamerFunc,
 	num int, follow bool,
-	allSteps bool) *taskrun.LogReader {
+	allSteps bool, timestamps bool) *taskrun.LogReader {
 
 	return &taskrun.LogReader{
-		Run:      t.Name,
-		Task:     t.Task,
-		Number:   num,
-		Ns:       ns,
-		Clients:  clientSet,
-		Streamer: streamer,
-		Follow:   follow,
-		AllSteps: allSteps,
+		Run:        t.Name,
+		Task:       t.Task,
+		Number:     num,
+		Ns:         ns,
+		Clients:    clientSet,
+		Streamer:   streamer,
+		Follow:     follow,
+		AllSteps:   allSteps,
+		Timestamps: timestamps,
 	}
 }
 
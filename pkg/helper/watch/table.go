@@ -0,0 +1,128 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/mattn/go-isatty"
+	"k8s.io/client-go/tools/cache"
+)
+
+// clearScreen moves the cursor to the top left corner of the terminal and
+// clears it
+const clearScreen = "\033[H\033[2J"
+
+// Table redraws a table each time the watched resources change and, when
+// writing to a terminal, every Interval so that the ages it shows keep
+// ticking. Redraws are appended one after the other otherwise.
+type Table struct {
+	Out      io.Writer
+	Clock    clockwork.Clock
+	Interval time.Duration
+	Render   func(w io.Writer) error
+}
+
+// Run draws the table and redraws it until stop is closed
+func (t *Table) Run(changes <-chan struct{}, stop <-chan struct{}) error {
+	terminal := isTerminal(t.Out)
+
+	for first := true; ; first = false {
+		// the table is rendered from the current state, which includes
+		// the changes notified so far
+		drain(changes)
+
+		var b bytes.Buffer
+		if err := t.Render(&b); err != nil {
+			return err
+		}
+		switch {
+		case terminal:
+			fmt.Fprint(t.Out, clearScreen)
+		case !first:
+			fmt.Fprintln(t.Out)
+		}
+		if _, err := b.WriteTo(t.Out); err != nil {
+			return err
+		}
+
+		var tick <-chan time.Time
+		if terminal {
+			tick = t.Clock.After(t.Interval)
+		}
+		select {
+		case <-stop:
+			return nil
+		case <-changes:
+		case <-tick:
+		}
+	}
+}
+
+// Changes returns a channel receiving a value whenever an object is added,
+// updated or deleted in any of the informers. Changes happening while the
+// previous one has not been received yet are coalesced.
+func Changes(informers ...cache.SharedIndexInformer) <-chan struct{} {
+	changes := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case changes <- struct{}{}:
+		default:
+		}
+	}
+
+	for _, informer := range informers {
+		informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    func(_ interface{}) { notify() },
+			UpdateFunc: func(_, _ interface{}) { notify() },
+			DeleteFunc: func(_ interface{}) { notify() },
+		})
+	}
+	return changes
+}
+
+func drain(changes <-chan struct{}) {
+	for {
+		select {
+		case <-changes:
+		default:
+			return
+		}
+	}
+}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && isatty.IsTerminal(f.Fd())
+}
+
+// Interrupted returns a channel closed when the command is interrupted
+func Interrupted() <-chan struct{} {
+	stop := make(chan struct{})
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sig
+		close(stop)
+	}()
+	return stop
+}
@@ -0,0 +1,79 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/tektoncd/cli/pkg/test"
+)
+
+func TestTable_Run(t *testing.T) {
+	out := &bytes.Buffer{}
+	changes := make(chan struct{})
+	stop := make(chan struct{})
+
+	draws := 0
+	drawn := make(chan struct{}, 3)
+	table := &Table{
+		Out:      out,
+		Clock:    clockwork.NewFakeClock(),
+		Interval: time.Second,
+		Render: func(w io.Writer) error {
+			draws++
+			fmt.Fprintf(w, "draw %d\n", draws)
+			drawn <- struct{}{}
+			return nil
+		},
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- table.Run(changes, stop)
+	}()
+	<-drawn
+	changes <- struct{}{}
+	<-drawn
+	changes <- struct{}{}
+	<-drawn
+	close(stop)
+
+	if err := <-done; err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	test.AssertOutput(t, "draw 1\n\ndraw 2\n\ndraw 3\n", out.String())
+}
+
+func TestTable_Run_error(t *testing.T) {
+	table := &Table{
+		Out:      &bytes.Buffer{},
+		Clock:    clockwork.NewFakeClock(),
+		Interval: time.Second,
+		Render: func(w io.Writer) error {
+			return fmt.Errorf("render failed")
+		},
+	}
+
+	err := table.Run(nil, nil)
+	if err == nil {
+		t.Fatalf("Expected error, got nil")
+	}
+	test.AssertOutput(t, "render failed", err.Error())
+}
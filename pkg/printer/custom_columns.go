@@ -0,0 +1,155 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+const (
+	customColumnsPrefix = "custom-columns="
+	noneValue           = "<none>"
+)
+
+type column struct {
+	Header string
+	Path   *jsonpath.JSONPath
+}
+
+// CustomColumnsPrinter prints a table whose columns are given as a header
+// and a JSONPath expression, e.g. NAME:.metadata.name,SA:.spec.serviceAccountName
+type CustomColumnsPrinter struct {
+	columns []column
+}
+
+// NewCustomColumnsPrinter parses the comma separated HEADER:JSONPATH columns
+// given to -o custom-columns=
+func NewCustomColumnsPrinter(spec string) (*CustomColumnsPrinter, error) {
+	if spec == "" {
+		return nil, fmt.Errorf("custom-columns format specified but no custom columns given")
+	}
+
+	p := &CustomColumnsPrinter{}
+	for _, c := range strings.Split(spec, ",") {
+		parts := strings.SplitN(c, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("unexpected custom-columns spec: %s, expected <header>:<json-path-expr>", c)
+		}
+
+		path := jsonpath.New(parts[0]).AllowMissingKeys(true)
+		if err := path.Parse(relaxedJSONPath(parts[1])); err != nil {
+			return nil, fmt.Errorf("invalid JSONPath %q of column %s: %v", parts[1], parts[0], err)
+		}
+		p.columns = append(p.columns, column{Header: parts[0], Path: path})
+	}
+	return p, nil
+}
+
+// relaxedJSONPath accepts the expressions without their braces and leading
+// dot, like kubectl does, e.g. metadata.name for {.metadata.name}
+func relaxedJSONPath(path string) string {
+	if strings.HasPrefix(path, "{") && strings.HasSuffix(path, "}") {
+		return path
+	}
+	return "{." + strings.TrimPrefix(path, ".") + "}"
+}
+
+// PrintObj prints a row for the object, or for each of its items when it is
+// a list
+func (p *CustomColumnsPrinter) PrintObj(o runtime.Object, out io.Writer) error {
+	items := []runtime.Object{o}
+	if meta.IsListType(o) {
+		var err error
+		if items, err = meta.ExtractList(o); err != nil {
+			return err
+		}
+	}
+
+	w := tabwriter.NewWriter(out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	headers := []string{}
+	for _, c := range p.columns {
+		headers = append(headers, c.Header)
+	}
+	fmt.Fprintln(w, strings.Join(headers, "\t"))
+
+	for _, item := range items {
+		data, err := toJSONData(item)
+		if err != nil {
+			return err
+		}
+
+		values := []string{}
+		for _, c := range p.columns {
+			v, err := columnValue(c.Path, data)
+			if err != nil {
+				return fmt.Errorf("failed to get the value of column %s: %v", c.Header, err)
+			}
+			values = append(values, v)
+		}
+		fmt.Fprintln(w, strings.Join(values, "\t"))
+	}
+	return w.Flush()
+}
+
+// toJSONData returns the object the way it is printed with -o json, for the
+// JSONPath expressions to match the fields users see
+func toJSONData(o runtime.Object) (interface{}, error) {
+	b, err := json.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+
+	var data interface{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func columnValue(path *jsonpath.JSONPath, data interface{}) (string, error) {
+	results, err := path.FindResults(data)
+	if err != nil {
+		return "", err
+	}
+
+	values := []string{}
+	for _, r := range results {
+		for _, v := range r {
+			if !v.IsValid() || (v.Kind() == reflect.Interface && v.IsNil()) {
+				continue
+			}
+			var b bytes.Buffer
+			if err := path.PrintResults(&b, []reflect.Value{v}); err != nil {
+				return "", err
+			}
+			values = append(values, b.String())
+		}
+	}
+
+	if len(values) == 0 {
+		return noneValue, nil
+	}
+	return strings.Join(values, ","), nil
+}
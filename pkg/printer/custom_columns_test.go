@@ -0,0 +1,110 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/test"
+	tb "github.com/tektoncd/pipeline/test/builder"
+	cliopts "k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+func TestCustomColumnsPrinter(t *testing.T) {
+	task := tb.Task("build", "ns", tb.TaskSpec(
+		tb.Step("compile", "golang"),
+		tb.Step("test", "golang"),
+	))
+
+	p, err := NewCustomColumnsPrinter("NAME:metadata.name,STEPS:.spec.steps[*].name,TIMEOUT:{.spec.timeout}")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var b bytes.Buffer
+	if err := p.PrintObj(task, &b); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	expected := "NAME    STEPS          TIMEOUT\nbuild   compile,test   <none>\n"
+	test.AssertOutput(t, expected, b.String())
+}
+
+func TestNewCustomColumnsPrinter_errors(t *testing.T) {
+	testParams := []struct {
+		name     string
+		spec     string
+		expected string
+	}{
+		{
+			name:     "no columns",
+			spec:     "",
+			expected: "custom-columns format specified but no custom columns given",
+		},
+		{
+			name:     "no path",
+			spec:     "NAME:.metadata.name,STATUS:",
+			expected: "unexpected custom-columns spec: STATUS:, expected <header>:<json-path-expr>",
+		},
+		{
+			name:     "invalid path",
+			spec:     "NAME:{.metadata.name",
+			expected: "invalid JSONPath \"{.metadata.name\" of column NAME: unrecognized character in action: U+007B '{'",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			_, err := NewCustomColumnsPrinter(tp.spec)
+			if err == nil {
+				t.Fatalf("Expected error, got nil")
+			}
+			test.AssertOutput(t, tp.expected, err.Error())
+		})
+	}
+}
+
+func TestAddListFlags(t *testing.T) {
+	c := &cobra.Command{}
+	AddListFlags(c, cliopts.NewPrintFlags("list"), OutputWide)
+
+	expected := "Output format. One of: json|yaml|name|go-template|go-template-file|template|templatefile|jsonpath|jsonpath-file|wide|custom-columns=HEADER:JSONPATH,...."
+	test.AssertOutput(t, expected, c.Flags().Lookup("output").Usage)
+}
+
+func TestAcceptsFormat(t *testing.T) {
+	c := &cobra.Command{}
+	AddListFlags(c, cliopts.NewPrintFlags("list"), OutputWide)
+	f := c.Flags().Lookup("output")
+
+	for _, format := range []string{"yaml", "wide", "jsonpath={.items[*].metadata.name}", "custom-columns=NAME:.metadata.name"} {
+		if !AcceptsFormat(f, format) {
+			t.Errorf("format %q should be accepted", format)
+		}
+	}
+	for _, format := range []string{"", "table", "yamlx", "custom-columns"} {
+		if AcceptsFormat(f, format) {
+			t.Errorf("format %q should not be accepted", format)
+		}
+	}
+
+	c = &cobra.Command{}
+	cliopts.NewPrintFlags("describe").AddFlags(c)
+	if AcceptsFormat(c.Flags().Lookup("output"), "yaml") {
+		t.Error("the formats of a describe command should not be accepted")
+	}
+}
@@ -15,16 +15,82 @@
 package printer
 
 import (
+	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
+	"strings"
 
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/runtime"
 	cliopts "k8s.io/cli-runtime/pkg/genericclioptions"
 )
 
+// OutputWide is the output format of the list commands printing their table
+// with extra columns
+const OutputWide = "wide"
+
+// formatsAnnotation annotates the output flag of the list commands with the
+// formats they accept
+const formatsAnnotation = "tkn_output_formats"
+
 func PrintObject(out io.Writer, o runtime.Object, f *cliopts.PrintFlags) error {
+	if f.OutputFormat != nil && strings.HasPrefix(*f.OutputFormat, customColumnsPrefix) {
+		printer, err := NewCustomColumnsPrinter(strings.TrimPrefix(*f.OutputFormat, customColumnsPrefix))
+		if err != nil {
+			return err
+		}
+		return printer.PrintObj(o, out)
+	}
+
 	printer, err := f.ToPrinter()
 	if err != nil {
 		return err
 	}
 	return printer.PrintObj(o, out)
 }
+
+// AddListFlags adds the print flags of a list command, along with the
+// custom-columns output format and the formats the command prints itself,
+// like wide
+func AddListFlags(c *cobra.Command, f *cliopts.PrintFlags, formats ...string) {
+	f.AddFlags(c)
+
+	formats = append(append(f.AllowedFormats(), formats...), customColumnsPrefix)
+	_ = c.Flags().SetAnnotation("output", formatsAnnotation, formats)
+	c.Flags().Lookup("output").Usage = fmt.Sprintf("Output format. One of: %s.", strings.Join(formats, "|")+"HEADER:JSONPATH,...")
+}
+
+// AcceptsFormat returns whether the output flag of a list command accepts the
+// format, i.e. one of its formats, with an argument for the ones taking one
+func AcceptsFormat(f *pflag.Flag, format string) bool {
+	for _, accepted := range f.Annotations[formatsAnnotation] {
+		if format == accepted || strings.HasPrefix(format, strings.TrimSuffix(accepted, "=")+"=") {
+			return true
+		}
+	}
+	return false
+}
+
+// DescribeTemplate returns the template given with --template, which can be
+// either a template string or the path to a template file, or the default
+// template when the flag is not set
+func DescribeTemplate(f *cliopts.PrintFlags, defaultTemplate string) (string, error) {
+	if f.TemplatePrinterFlags == nil ||
+		f.TemplatePrinterFlags.TemplateArgument == nil ||
+		*f.TemplatePrinterFlags.TemplateArgument == "" {
+		return defaultTemplate, nil
+	}
+
+	templ := *f.TemplatePrinterFlags.TemplateArgument
+	if _, err := os.Stat(templ); err != nil {
+		return templ, nil
+	}
+
+	content, err := ioutil.ReadFile(templ)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
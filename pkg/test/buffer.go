@@ -0,0 +1,54 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// SyncBuffer is a buffer which can be written by a command running in the
+// background, e.g. watching resources, while the test reads it
+type SyncBuffer struct {
+	mu sync.Mutex
+	b  bytes.Buffer
+}
+
+func (s *SyncBuffer) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.b.Write(p)
+}
+
+func (s *SyncBuffer) String() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.b.String()
+}
+
+// WaitForSuffix waits up to 5 seconds for the output written last to be the
+// expected one
+func (s *SyncBuffer) WaitForSuffix(t *testing.T, expected string) {
+	t.Helper()
+	for i := 0; i < 100 && !strings.HasSuffix(s.String(), expected); i++ {
+		time.Sleep(50 * time.Millisecond)
+	}
+	if !strings.HasSuffix(s.String(), expected) {
+		t.Errorf("Expected the output to end with:\n%s\ngot:\n%s", expected, s.String())
+	}
+}
@@ -28,3 +28,13 @@ func TaskCreationTime(t time.Time) tb.TaskOp {
 		task.CreationTimestamp = metav1.Time{Time: t}
 	}
 }
+
+// TaskLabel adds a label to the task
+func TaskLabel(key, value string) tb.TaskOp {
+	return func(task *v1alpha1.Task) {
+		if task.Labels == nil {
+			task.Labels = map[string]string{}
+		}
+		task.Labels[key] = value
+	}
+}
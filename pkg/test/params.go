@@ -23,6 +23,7 @@ import (
 
 type Params struct {
 	ns, kConfig, kContext string
+	apiVersion            string
 	Tekton                versioned.Interface
 	Kube                  k8s.Interface
 	Clock                 clockwork.Clock
@@ -49,6 +50,10 @@ func (p *Params) SetKubeContext(context string) {
 	p.kContext = context
 }
 
+func (p *Params) SetAPIVersion(version string) {
+	p.apiVersion = version
+}
+
 func (p *Params) KubeConfigPath() string {
 	return p.kConfig
 }
@@ -76,9 +81,18 @@ func (p *Params) Clients() (*cli.Clients, error) {
 		return nil, err
 	}
 
+	apiVersion := p.apiVersion
+	if apiVersion == "" {
+		apiVersion = cli.V1alpha1
+	}
+	if err := cli.CheckAPIVersion(apiVersion); err != nil {
+		return nil, err
+	}
+
 	p.Cls = &cli.Clients{
-		Tekton: tekton,
-		Kube:   kube,
+		Tekton:     tekton,
+		Kube:       kube,
+		APIVersion: apiVersion,
 	}
 
 	return p.Cls, nil